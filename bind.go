@@ -0,0 +1,469 @@
+package golam
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMultipartMaxMemory = 32 << 20 // 32 MB
+	defaultMaxBodySize        = 10 << 20 // 10 MB
+)
+
+var ErrBodyTooLarge = errors.New("request body too large")
+
+const (
+	bindTagPath   = "path"
+	bindTagQuery  = "query"
+	bindTagHeader = "header"
+	bindTagForm   = "form"
+)
+
+type Binder interface {
+	Bind(c Context, i interface{}) error
+}
+
+var _ Binder = (*DefaultBinder)(nil)
+
+// DefaultBinder fills struct fields tagged with `path`, `query`, `header` and `form`,
+// and decodes the body by Content-Type (JSON, XML, form-urlencoded, multipart).
+type DefaultBinder struct {
+	// MultipartMaxMemory is passed to multipart.Reader.ReadForm, which streams the request body. default: 32 MB
+	// larger files are stored in temporary files, which are removed after the response is committed.
+	// when a Context not created by Golam is bound, the caller must remove them with multipart.Form.RemoveAll.
+	MultipartMaxMemory int64
+
+	// MaxBodySize limits JSON, XML and form-urlencoded bodies. default: 10 MB
+	// a larger body is a 413 HTTPError.
+	MaxBodySize int64
+}
+
+// multipartFormOwner is a Context which removes temporary files of multipart forms after the response.
+type multipartFormOwner interface {
+	addMultipartForm(form *multipart.Form)
+}
+
+func (b *DefaultBinder) Bind(c Context, i interface{}) error {
+	if err := b.BindPathParams(c, i); err != nil {
+		return err
+	}
+
+	if err := b.BindQueryParams(c, i); err != nil {
+		return err
+	}
+
+	if err := b.BindHeaders(c, i); err != nil {
+		return err
+	}
+
+	return b.BindBody(c, i)
+}
+
+func (b *DefaultBinder) BindPathParams(c Context, i interface{}) error {
+	pathParams := c.PathParams()
+	if len(pathParams) == 0 {
+		return nil
+	}
+
+	data := make(map[string][]string, len(pathParams))
+	for k, v := range pathParams {
+		data[k] = []string{v.Value}
+	}
+
+	return bindDataOrHTTPError(i, data, bindTagPath)
+}
+
+func (b *DefaultBinder) BindQueryParams(c Context, i interface{}) error {
+	return bindDataOrHTTPError(i, c.QueryParams(), bindTagQuery)
+}
+
+func (b *DefaultBinder) BindHeaders(c Context, i interface{}) error {
+	return bindDataOrHTTPError(i, c.Request().Header, bindTagHeader)
+}
+
+// BindBody decodes the request body by Content-Type. the body is read from the request and is consumed.
+func (b *DefaultBinder) BindBody(c Context, i interface{}) error {
+	req := c.Request()
+	if req.Body == nil || req.ContentLength == 0 {
+		return nil
+	}
+
+	contentType := req.Header.Get(HeaderContentType)
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil && contentType != "" {
+		return NewHTTPError(http.StatusUnsupportedMediaType).SetInternal(err)
+	}
+
+	maxBodySize := b.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxBodySize
+	}
+	body := &limitedReader{r: req.Body, n: maxBodySize}
+
+	switch {
+	case mediaType == MIMEApplicationJSON:
+		if err = json.NewDecoder(body).Decode(i); err != nil && err != io.EOF {
+			return newBodyHTTPError(err)
+		}
+	case mediaType == MIMEApplicationXML, mediaType == MIMETextXML:
+		if err = xml.NewDecoder(body).Decode(i); err != nil && err != io.EOF {
+			return newBodyHTTPError(err)
+		}
+	case mediaType == MIMEApplicationForm:
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return newBodyHTTPError(err)
+		}
+
+		form, err := url.ParseQuery(string(data))
+		if err != nil {
+			return newBindHTTPError(err)
+		}
+
+		return bindDataOrHTTPError(i, form, bindTagForm)
+	case mediaType == MIMEMultipartForm:
+		maxMemory := b.MultipartMaxMemory
+		if maxMemory <= 0 {
+			maxMemory = defaultMultipartMaxMemory
+		}
+
+		form, err := multipart.NewReader(req.Body, params["boundary"]).ReadForm(maxMemory)
+		if err != nil {
+			return newBindHTTPError(err)
+		}
+
+		if owner, ok := c.(multipartFormOwner); ok {
+			owner.addMultipartForm(form)
+		}
+
+		if err = bindDataOrHTTPError(i, form.Value, bindTagForm); err != nil {
+			return err
+		}
+
+		return bindMultipartFiles(i, form.File)
+	default:
+		return NewHTTPError(http.StatusUnsupportedMediaType)
+	}
+
+	return nil
+}
+
+// limitedReader returns ErrBodyTooLarge once more than n bytes are read.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, ErrBodyTooLarge
+	}
+
+	return n, err
+}
+
+func newBodyHTTPError(err error) *HTTPError {
+	if errors.Is(err, ErrBodyTooLarge) {
+		return NewHTTPError(http.StatusRequestEntityTooLarge).SetInternal(err)
+	}
+
+	return newBindHTTPError(err)
+}
+
+func newBindHTTPError(err error) *HTTPError {
+	return NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+}
+
+func bindDataOrHTTPError(i interface{}, data map[string][]string, tag string) error {
+	if err := bindData(i, data, tag); err != nil {
+		return newBindHTTPError(err)
+	}
+
+	return nil
+}
+
+func bindData(i interface{}, data map[string][]string, tag string) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	rv := reflect.ValueOf(i)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("binding target must be a non-nil pointer")
+	}
+
+	rv = rv.Elem()
+	if rv.Kind() == reflect.Map {
+		if rv.Type().Key().Kind() != reflect.String || (tag != bindTagQuery && tag != bindTagForm) {
+			return nil
+		}
+		return bindMap(rv, data)
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if tag == bindTagHeader {
+		canonical := make(map[string][]string, len(data))
+		for k, v := range data {
+			canonical[textproto.CanonicalMIMEHeaderKey(k)] = v
+		}
+		data = canonical
+	}
+
+	return bindStruct(rv, data, tag)
+}
+
+func bindMap(rv reflect.Value, data map[string][]string) error {
+	keyType, elemType := rv.Type().Key(), rv.Type().Elem()
+	isStrings := elemType.Kind() == reflect.Slice && elemType.Elem().Kind() == reflect.String
+	if elemType.Kind() != reflect.String && elemType.Kind() != reflect.Interface && !isStrings {
+		return nil
+	}
+
+	if rv.IsNil() {
+		rv.Set(reflect.MakeMap(rv.Type()))
+	}
+
+	for k, v := range data {
+		var ev reflect.Value
+		switch {
+		case isStrings:
+			ev = reflect.ValueOf(v).Convert(elemType)
+		case elemType.Kind() == reflect.String:
+			ev = reflect.ValueOf(v[0]).Convert(elemType)
+		default:
+			ev = reflect.ValueOf(v[0])
+		}
+
+		rv.SetMapIndex(reflect.ValueOf(k).Convert(keyType), ev)
+	}
+
+	return nil
+}
+
+func bindStruct(rv reflect.Value, data map[string][]string, tag string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		fv := rv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		name, ok := fieldTagName(sf, tag)
+		if !ok {
+			if sf.Anonymous && fv.Kind() == reflect.Struct {
+				if err := bindStruct(fv, data, tag); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			continue
+		}
+
+		if tag == bindTagHeader {
+			name = textproto.CanonicalMIMEHeaderKey(name)
+		}
+
+		values, ok := data[name]
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		if err := setField(fv, values); err != nil {
+			return fmt.Errorf("%s %q: %w", tag, name, err)
+		}
+	}
+
+	return nil
+}
+
+// fieldTagName returns the name in the tag of the field without options, e.g. "name" for `form:"name,omitempty"`.
+// ok is false when the field has no tag, the name is empty for "-".
+func fieldTagName(sf reflect.StructField, tag string) (name string, ok bool) {
+	name, ok = sf.Tag.Lookup(tag)
+	if !ok {
+		return "", false
+	}
+
+	if idx := strings.IndexByte(name, ','); idx != -1 {
+		name = name[:idx]
+	}
+
+	if name == "-" {
+		return "", true
+	}
+
+	return name, true
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+func setField(fv reflect.Value, values []string) error {
+	if fv.Kind() == reflect.Slice && !fv.Type().Implements(textUnmarshalerType) &&
+		!reflect.PtrTo(fv.Type()).Implements(textUnmarshalerType) {
+		slice := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i, v := range values {
+			if err := setValue(slice.Index(i), v); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+
+	return setValue(fv, values[0])
+}
+
+func setValue(fv reflect.Value, v string) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return setValue(fv.Elem(), v)
+	}
+
+	if fv.CanAddr() && fv.Addr().Type().Implements(textUnmarshalerType) {
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(v))
+	}
+
+	if fv.Type() == durationType {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(int64(d))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(v)
+	case reflect.Bool:
+		if v == "" {
+			v = "false"
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v == "" {
+			v = "0"
+		}
+		n, err := strconv.ParseInt(v, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v == "" {
+			v = "0"
+		}
+		n, err := strconv.ParseUint(v, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		if v == "" {
+			v = "0"
+		}
+		n, err := strconv.ParseFloat(v, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	case reflect.Interface:
+		if fv.NumMethod() != 0 {
+			return fmt.Errorf("unsupported type %s", fv.Type())
+		}
+		fv.Set(reflect.ValueOf(v))
+	default:
+		return fmt.Errorf("unsupported type %s", fv.Type())
+	}
+
+	return nil
+}
+
+var (
+	multipartFileHeaderPointerType      = reflect.TypeOf((*multipart.FileHeader)(nil))
+	multipartFileHeaderPointerSliceType = reflect.TypeOf([]*multipart.FileHeader(nil))
+)
+
+func bindMultipartFiles(i interface{}, files map[string][]*multipart.FileHeader) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	rv := reflect.ValueOf(i).Elem()
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	bindMultipartFilesStruct(rv, files)
+	return nil
+}
+
+func bindMultipartFilesStruct(rv reflect.Value, files map[string][]*multipart.FileHeader) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		fv := rv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		name, ok := fieldTagName(sf, bindTagForm)
+		if !ok {
+			if sf.Anonymous && fv.Kind() == reflect.Struct {
+				bindMultipartFilesStruct(fv, files)
+			}
+			continue
+		}
+
+		if name == "" {
+			continue
+		}
+
+		fhs := files[name]
+		if len(fhs) == 0 {
+			continue
+		}
+
+		switch fv.Type() {
+		case multipartFileHeaderPointerType:
+			fv.Set(reflect.ValueOf(fhs[0]))
+		case multipartFileHeaderPointerSliceType:
+			fv.Set(reflect.ValueOf(fhs))
+		}
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"encoding/xml"
 	"errors"
 	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambdacontext"
 	"io"
+	"mime/multipart"
 	"net"
 	"net/http"
 	"net/url"
@@ -18,6 +20,9 @@ const (
 	defaultIndent = "\t"
 )
 
+// Context is the request context passed to handlers and middleware.
+// contexts are pooled and reused for other requests, so a Context and values from it, such as PathParams,
+// must not be used after the handler returns. copy the values first, e.g. before starting a goroutine.
 type Context interface {
 	Ctx() context.Context
 
@@ -47,6 +52,7 @@ type Context interface {
 
 	SetPath(p string)
 
+	// PathParams returns the path parameters of the route, which is reused by the next request of the pooled Context.
 	PathParams() PathParams
 
 	SetPathParams(pathParams PathParams)
@@ -55,6 +61,15 @@ type Context interface {
 
 	SetQueryParams(query url.Values)
 
+	// Query returns the query parameters with typed getters, e.g. c.Query().IntOr("page", 1).
+	Query() QueryValues
+
+	Bind(i interface{}) error
+
+	Validate(i interface{}) error
+
+	BindAndValidate(i interface{}) error
+
 	Cookie(name string) (*http.Cookie, error)
 
 	SetCookie(cookie *http.Cookie)
@@ -93,11 +108,9 @@ type Context interface {
 
 	Redirect(status int, url string) error
 
-	// TODO
-	//Logger() log.Logger
+	Logger() Logger
 
-	// TODO
-	//SetLogger(l log.Logger)
+	SetLogger(l Logger)
 
 	Golam() *Golam
 
@@ -105,27 +118,81 @@ type Context interface {
 
 	PrimalRequestLambda() *events.APIGatewayV2HTTPRequest
 
+	PrimalRequestLambdaV1() *events.APIGatewayProxyRequest
+
+	PrimalRequestALB() *events.ALBTargetGroupRequest
+
+	PrimalRequestFunctionURL() *events.LambdaFunctionURLRequest
+
 	PrimalRequestHTTP() *http.Request
 }
 
 var _ Context = (*contextImpl)(nil)
 
 type contextImpl struct {
-	ctx                 context.Context
-	request             *http.Request
-	requestBodyBytes    []byte
-	response            *Response
-	path                string
-	pathParams          PathParams
-	query               url.Values
-	handler             HandlerFunc
-	golam               *Golam
-	primalRequestLambda *events.APIGatewayV2HTTPRequest
-	primalRequestHTTP   *http.Request
+	ctx               context.Context
+	request           *http.Request
+	requestBodyBytes  []byte
+	response          *Response
+	path              string
+	pathParams        PathParams
+	query             url.Values
+	handler           HandlerFunc
+	golam             *Golam
+	primalRequest     interface{}
+	primalRequestHTTP *http.Request
+	logger            Logger
+	coldStart         bool
+	apiGatewayRoute   apiGatewayRoute
+
+	// storage reused by pooled contexts.
+	responseValue     Response
+	httpAdapter       responseHTTPAdapter
+	pathParamsStorage PathParams
+
+	// multipartForms is removed by Reset, as bound files may be stored in temporary files.
+	multipartForms []*multipart.Form
+}
+
+// pathParamsCapacity is the initial size of path param storage of pooled contexts.
+const pathParamsCapacity = 4
+
+func newContext(g *Golam) *contextImpl {
+	c := &contextImpl{
+		golam:             g,
+		pathParamsStorage: make(PathParams, pathParamsCapacity),
+	}
+	c.pathParams = c.pathParamsStorage
+	return c
+}
 
-	// TODO
-	//logger   log.Logger
+// Reset clears c for the next request, keeping the path param storage.
+// temporary files of bound multipart forms are removed.
+func (c *contextImpl) Reset() {
+	for _, form := range c.multipartForms {
+		if err := form.RemoveAll(); err != nil {
+			c.Logger().Warn("failed to remove multipart form files", LogKeyError, err)
+		}
+	}
+
+	for k := range c.pathParamsStorage {
+		delete(c.pathParamsStorage, k)
+	}
+
+	*c = contextImpl{
+		golam:             c.golam,
+		pathParams:        c.pathParamsStorage,
+		pathParamsStorage: c.pathParamsStorage,
+	}
+}
 
+func (c *contextImpl) addMultipartForm(form *multipart.Form) {
+	c.multipartForms = append(c.multipartForms, form)
+}
+
+func (c *contextImpl) setResponseAdapter(adapter ResponseAdapter) {
+	c.responseValue = Response{adapter: adapter}
+	c.response = &c.responseValue
 }
 
 func (c *contextImpl) Ctx() context.Context {
@@ -177,7 +244,7 @@ func (c *contextImpl) RequestBodyBytes() []byte {
 	var err error
 	c.requestBodyBytes, err = io.ReadAll(tee)
 	if err != nil {
-		//TODO: logging
+		c.Logger().Error("failed to read request body", LogKeyError, err)
 		return []byte{}
 	}
 
@@ -229,7 +296,11 @@ func (c *contextImpl) SetPathParams(pathParams PathParams) {
 	c.pathParams = pathParams
 }
 
+// QueryParams parses the query of the request on first use, if it is not set.
 func (c *contextImpl) QueryParams() url.Values {
+	if c.query == nil && c.request != nil {
+		c.query = c.request.URL.Query()
+	}
 	return c.query
 }
 
@@ -237,6 +308,36 @@ func (c *contextImpl) SetQueryParams(query url.Values) {
 	c.query = query
 }
 
+func (c *contextImpl) Query() QueryValues {
+	return QueryValues(c.QueryParams())
+}
+
+func (c *contextImpl) Bind(i interface{}) error {
+	return c.golam.Binder.Bind(c, i)
+}
+
+func (c *contextImpl) Validate(i interface{}) error {
+	if c.golam.Validator == nil {
+		return ErrValidatorNotRegistered
+	}
+
+	err := c.golam.Validator.Validate(i)
+	var ve ValidationErrors
+	if errors.As(err, &ve) {
+		return newValidationHTTPError(ve)
+	}
+
+	return err
+}
+
+func (c *contextImpl) BindAndValidate(i interface{}) error {
+	if err := c.Bind(i); err != nil {
+		return err
+	}
+
+	return c.Validate(i)
+}
+
 func (c *contextImpl) Cookie(name string) (*http.Cookie, error) {
 	return c.Request().Cookie(name)
 }
@@ -362,20 +463,78 @@ func (c *contextImpl) Redirect(status int, url string) error {
 	return nil
 }
 
+func (c *contextImpl) Logger() Logger {
+	if c.logger == nil {
+		c.logger = c.golam.Logger.With(c.loggerKeyvals()...)
+	}
+	return c.logger
+}
+
+func (c *contextImpl) SetLogger(l Logger) {
+	c.logger = l
+}
+
+func (c *contextImpl) loggerKeyvals() (keyvals []interface{}) {
+	switch req := c.primalRequest.(type) {
+	case *events.APIGatewayV2HTTPRequest:
+		keyvals = append(keyvals,
+			LogKeyRequestID, req.RequestContext.RequestID,
+			LogKeyRouteKey, req.RouteKey,
+		)
+	case *events.APIGatewayProxyRequest:
+		keyvals = append(keyvals,
+			LogKeyRequestID, req.RequestContext.RequestID,
+			LogKeyRouteKey, req.HTTPMethod+" "+req.Resource,
+		)
+	case *events.LambdaFunctionURLRequest:
+		keyvals = append(keyvals,
+			LogKeyRequestID, req.RequestContext.RequestID,
+		)
+	case *events.ALBTargetGroupRequest:
+		keyvals = append(keyvals,
+			LogKeyTraceID, c.request.Header.Get(HeaderXAmznTraceID),
+		)
+	default:
+		return
+	}
+
+	if lc, ok := lambdacontext.FromContext(c.Ctx()); ok {
+		keyvals = append(keyvals, LogKeyAWSRequestID, lc.AwsRequestID)
+	}
+
+	return append(keyvals, LogKeyColdStart, c.coldStart)
+}
+
 func (c *contextImpl) Golam() *Golam {
 	return c.golam
 }
 
 func (c *contextImpl) PrimalRequest() interface{} {
-	if isLambdaRuntime() {
-		return c.PrimalRequestLambda()
+	if c.primalRequest != nil {
+		return c.primalRequest
 	}
 
 	return c.PrimalRequestHTTP()
 }
 
 func (c *contextImpl) PrimalRequestLambda() *events.APIGatewayV2HTTPRequest {
-	return c.primalRequestLambda
+	req, _ := c.primalRequest.(*events.APIGatewayV2HTTPRequest)
+	return req
+}
+
+func (c *contextImpl) PrimalRequestLambdaV1() *events.APIGatewayProxyRequest {
+	req, _ := c.primalRequest.(*events.APIGatewayProxyRequest)
+	return req
+}
+
+func (c *contextImpl) PrimalRequestALB() *events.ALBTargetGroupRequest {
+	req, _ := c.primalRequest.(*events.ALBTargetGroupRequest)
+	return req
+}
+
+func (c *contextImpl) PrimalRequestFunctionURL() *events.LambdaFunctionURLRequest {
+	req, _ := c.primalRequest.(*events.LambdaFunctionURLRequest)
+	return req
 }
 
 func (c *contextImpl) PrimalRequestHTTP() *http.Request {
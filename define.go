@@ -9,6 +9,10 @@ const (
 	MIMETextHTMLCharsetUTF8        = MIMETextHTML + "; " + charsetUTF8
 	MIMETextPlain                  = "text/plain"
 	MIMETextPlainCharsetUTF8       = MIMETextPlain + "; " + charsetUTF8
+	MIMETextXML                    = "text/xml"
+	MIMETextXMLCharsetUTF8         = MIMETextXML + "; " + charsetUTF8
+	MIMEApplicationForm            = "application/x-www-form-urlencoded"
+	MIMEMultipartForm              = "multipart/form-data"
 )
 
 const (
@@ -64,6 +68,7 @@ const (
 	HeaderContentSecurityPolicyReportOnly = "Content-Security-Policy-Report-Only"
 	HeaderXCSRFToken                      = "X-CSRF-Token"
 	HeaderReferrerPolicy                  = "Referrer-Policy"
+	HeaderXAmznTraceID                    = "X-Amzn-Trace-Id"
 )
 
 var (
@@ -0,0 +1,85 @@
+package golam
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type (
+	HTTPError struct {
+		Code     int         `json:"-"`
+		Message  interface{} `json:"message"`
+		Internal error       `json:"-"`
+	}
+
+	HTTPErrorHandler func(err error, c Context)
+)
+
+var _ error = (*HTTPError)(nil)
+
+func NewHTTPError(code int, message ...interface{}) *HTTPError {
+	he := &HTTPError{
+		Code:    code,
+		Message: http.StatusText(code),
+	}
+
+	if len(message) > 0 {
+		he.Message = message[0]
+	}
+
+	return he
+}
+
+func (he *HTTPError) Error() string {
+	if he.Internal == nil {
+		return fmt.Sprintf("code=%d, message=%v", he.Code, he.Message)
+	}
+
+	return fmt.Sprintf("code=%d, message=%v, internal=%v", he.Code, he.Message, he.Internal)
+}
+
+func (he *HTTPError) SetInternal(err error) *HTTPError {
+	he.Internal = err
+	return he
+}
+
+func (he *HTTPError) Unwrap() error {
+	return he.Internal
+}
+
+type httpErrorMessage struct {
+	Message string `json:"message"`
+}
+
+// DefaultHTTPErrorHandler writes err as a JSON body.
+// errors other than *HTTPError are reported as 500 Internal Server Error.
+// errors after the response has been written are only logged.
+func DefaultHTTPErrorHandler(err error, c Context) {
+	if c.Response().Written {
+		c.Logger().Error("error after response was written", LogKeyError, err)
+		return
+	}
+
+	var he *HTTPError
+	if !errors.As(err, &he) {
+		he = NewHTTPError(http.StatusInternalServerError)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		_ = c.NoContent(he.Code)
+		return
+	}
+
+	var body interface{}
+	switch m := he.Message.(type) {
+	case string:
+		body = httpErrorMessage{Message: m}
+	case error:
+		body = httpErrorMessage{Message: m.Error()}
+	default:
+		body = m
+	}
+
+	_ = c.JSON(he.Code, body)
+}
@@ -10,25 +10,36 @@ import (
 	"github.com/aws/aws-lambda-go/lambda"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 func New() (g *Golam) {
 	g = &Golam{
-		isLambdaRuntime: isLambdaRuntime(),
-		NotFoundHandler: DefaultNotFound,
+		isLambdaRuntime:         isLambdaRuntime(),
+		NotFoundHandler:         DefaultNotFound,
+		MethodNotAllowedHandler: DefaultMethodNotAllowed,
+		HTTPErrorHandler:        DefaultHTTPErrorHandler,
+		Logger:                  NewJSONLogger(os.Stdout, LogLevelInfo),
+		Binder:                  &DefaultBinder{},
+		Validator:               &DefaultValidator{},
 	}
 
+	g.router = newTreeRouter(g.routeConflict)
+	g.compile()
+	g.pool.New = func() interface{} {
+		return newContext(g)
+	}
 	if g.isLambdaRuntime {
-		g.router = newLambdaRouter()
 		g.LambdaHandler = &defaultLambdaHandler{golam: g}
 		g.start = func() error {
 			lambda.Start(g.LambdaHandler)
 			return nil
 		}
 	} else {
-		g.router = newLocalRouter()
 		g.LocalHandler = &defaultHttpHandler{golam: g}
 		g.start = func() error {
 			return http.ListenAndServe(g.LocalAddr, g.LocalHandler)
@@ -48,7 +59,8 @@ type (
 	}
 
 	defaultLambdaHandler struct {
-		golam *Golam
+		golam   *Golam
+		invoked uint32
 	}
 
 	HandlerFunc func(c Context) error
@@ -59,159 +71,263 @@ type (
 
 		LambdaHandler lambda.Handler
 
-		NotFoundHandler HandlerFunc
+		NotFoundHandler         HandlerFunc
+		MethodNotAllowedHandler HandlerFunc
+		HTTPErrorHandler        HTTPErrorHandler
+
+		Recover RecoverConfig
+
+		// AutoHeadOptions answers HEAD with the GET handler without body,
+		// and OPTIONS with the Allow header, for routes without own handlers.
+		AutoHeadOptions bool
+
+		// StrictRouting panics on route conflicts at registration, instead of logging a warning.
+		// conflicts are reported by Validate either way.
+		StrictRouting bool
+
+		Logger Logger
 
-		// TODO
-		//Logger log.Logger
+		Binder    Binder
+		Validator Validator
 
 		isLambdaRuntime bool
 		start           func() error
 		preMiddleware   []MiddlewareFunc
 		middleware      []MiddlewareFunc
 
-		router Router
+		// fallbackMiddleware is middleware added with Use, without UseForRoutes,
+		// which also wraps NotFoundHandler and MethodNotAllowedHandler.
+		fallbackMiddleware []MiddlewareFunc
+
+		router     Router
+		routeNames map[string]routeName
+		chains     middlewareChains
+
+		// pool is contexts reused across requests.
+		pool sync.Pool
 	}
 )
 
 func (d *defaultHttpHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	method := request.Method
-	reqPath := request.URL.Path
-
-	ctxImpl := &contextImpl{
-		request: request,
-		response: NewResponse(
-			NewResponseHTTPAdapter(writer),
-		),
-		path:              reqPath,
-		query:             request.URL.Query(),
-		golam:             d.golam,
-		primalRequestHTTP: request,
-	}
-
-	r := d.golam.router.FindRoute(reqPath)
-	if r != nil {
-		var params *[]routeParam
-		handler := r.handlers.getHandler(method)
-		if handler != nil {
-			ctxImpl.handler = handler
-			params = r.params.getParamsInfo(method)
-		} else {
-			ctxImpl.handler = r.handlers.getHandler("")
-			params = r.params.getParamsInfo("")
-		}
+	ctxImpl := d.golam.acquireContext()
+	defer d.golam.releaseContext(ctxImpl)
 
-		if params != nil && len(*params) > 0 {
-			ctxImpl.pathParams = make(PathParams)
-			parts := strings.Split(reqPath, "/")
-			for _, p := range *params {
-				if p.index >= len(parts) {
-					// error?
-					continue
-				}
-
-				if p.isGreedy {
-					ctxImpl.pathParams[p.key] = PathParam{
-						Key:   p.key,
-						Value: strings.Join(parts[p.index:], "/"),
-					}
-					break
-				}
-
-				ctxImpl.pathParams[p.key] = PathParam{
-					Key:   p.key,
-					Value: parts[p.index],
-				}
-			}
-
-		}
+	ctxImpl.request = request
+	ctxImpl.httpAdapter.writer = writer
+	ctxImpl.setResponseAdapter(&ctxImpl.httpAdapter)
+	ctxImpl.path = request.URL.Path
+	ctxImpl.primalRequestHTTP = request
 
+	if err := d.golam.servePath(ctxImpl); err != nil {
+		panic(err) // unreachable code
 	}
+}
 
-	if ctxImpl.handler == nil {
-		ctxImpl.handler = d.golam.NotFoundHandler
-	} else {
-		ctxImpl.handler = wrapMiddleware(ctxImpl.handler, append(d.golam.preMiddleware, d.golam.middleware...)...)
+func (d *defaultLambdaHandler) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+	coldStart := atomic.CompareAndSwapUint32(&d.invoked, 0, 1)
+
+	source, err := detectLambdaEventSource(payload)
+	if err != nil {
+		d.golam.Logger.Error("failed to unmarshal lambda payload", LogKeyError, err)
+		return nil, err
 	}
 
-	// TODO error handle
-	switch err := ctxImpl.handler(ctxImpl); err {
-	case nil:
-		err = ctxImpl.Response().Commit()
-		if err != nil {
-			panic(err) // unreachable code
-		}
+	switch source {
+	case lambdaEventSourceAPIGatewayV1:
+		return d.invokeAPIGatewayV1(ctx, payload, coldStart)
+	case lambdaEventSourceALB:
+		return d.invokeALB(ctx, payload, coldStart)
+	case lambdaEventSourceFunctionURL:
+		return d.invokeFunctionURL(ctx, payload, coldStart)
 	default:
-		// TODO error handler
+		return d.invokeAPIGatewayV2(ctx, payload, coldStart)
 	}
 }
 
-func (d *defaultLambdaHandler) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+func (d *defaultLambdaHandler) invokeAPIGatewayV2(ctx context.Context, payload []byte, coldStart bool) ([]byte, error) {
 	var lReq events.APIGatewayV2HTTPRequest
 	err := json.Unmarshal(payload, &lReq)
 	if err != nil {
-		//TODO: logging
+		d.golam.Logger.Error("failed to unmarshal lambda payload", LogKeyError, err)
 		return nil, err
 	}
 
 	req, err := newHTTPRequestFromAPIGatewayV2HTTPRequest(ctx, &lReq)
 	if err != nil {
-		//TODO: logging
+		d.golam.Logger.Error("failed to convert lambda payload to http request", LogKeyError, err, LogKeyRequestID, lReq.RequestContext.RequestID)
 		return nil, err
 	}
 
 	var response events.APIGatewayV2HTTPResponse
-	ctxImpl := &contextImpl{
-		request: req,
-		response: NewResponse(
-			NewResponseLambdaAdapter(&response),
-		),
-		path:                lReq.RequestContext.HTTP.Path,
-		golam:               d.golam,
-		primalRequestLambda: &lReq,
-	}
+	ctxImpl := d.golam.acquireContext()
+	defer d.golam.releaseContext(ctxImpl)
+
+	ctxImpl.request = req
+	ctxImpl.setResponseAdapter(NewResponseLambdaAdapter(&response))
+	ctxImpl.path = lReq.RequestContext.HTTP.Path
+	ctxImpl.primalRequest = &lReq
+	ctxImpl.coldStart = coldStart
 
 	ctxImpl.query, _ = url.ParseQuery(lReq.RawQueryString)
 
-	pathKey := lReq.RouteKey[strings.Index(lReq.RouteKey, " ")+1:]
-	r := d.golam.Router().FindRoute(pathKey)
-	if r != nil {
-		handler := r.handlers.getHandler(req.Method)
-		if handler != nil {
-			ctxImpl.handler = handler
-		} else {
-			ctxImpl.handler = r.handlers.getHandler("")
-		}
+	ctxImpl.path = stripStagePrefix(ctxImpl.path, lReq.RequestContext.Stage, lReq.RequestContext.DomainName)
 
-		if len(lReq.PathParameters) > 0 {
-			ctxImpl.pathParams = make(PathParams)
-			for k, v := range lReq.PathParameters {
-				ctxImpl.pathParams[k] = PathParam{
-					Key:   k,
-					Value: v,
-				}
-			}
+	routeKeyPath := lReq.RouteKey[strings.Index(lReq.RouteKey, " ")+1:]
+	if err = d.golam.serveAPIGateway(ctxImpl, routeKeyPath, lReq.PathParameters); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(response)
+}
+
+// servePath matches c.path with the router, runs the handler and commits the response.
+func (g *Golam) servePath(c *contextImpl) error {
+	c.handler = g.chains.serve
+	g.handle(c)
+
+	err := c.Response().Commit()
+	if err != nil {
+		c.Logger().Error("failed to commit response", LogKeyError, err)
+		return err
+	}
+
+	return nil
+}
+
+// serveAPIGateway is servePath for API Gateway events.
+// the route key and path parameters resolved by API Gateway are only used to cross-check the router,
+// unless Pre middleware rewrote the path.
+func (g *Golam) serveAPIGateway(c *contextImpl, routeKeyPath string, pathParameters map[string]string) error {
+	c.apiGatewayRoute = apiGatewayRoute{
+		path:           c.path,
+		routeKeyPath:   routeKeyPath,
+		pathParameters: pathParameters,
+	}
+
+	return g.servePath(c)
+}
+
+type (
+	// middlewareChains is middleware chains compiled by compile, so that requests only call them.
+	middlewareChains struct {
+		// serve is Pre middleware around dispatch.
+		serve HandlerFunc
+
+		notFound         HandlerFunc
+		methodNotAllowed HandlerFunc
+		autoOptions      HandlerFunc
+	}
+
+	apiGatewayRoute struct {
+		path           string
+		routeKeyPath   string
+		pathParameters map[string]string
+	}
+)
+
+// compile builds middleware chains. it is called whenever middleware is added,
+// route handlers are compiled by the router when they are added.
+func (g *Golam) compile() {
+	g.router.Compile(g.wrapRoute)
+	g.chains = middlewareChains{
+		serve: wrapMiddleware(g.dispatch, g.preMiddleware...),
+		notFound: wrapMiddleware(func(c Context) error {
+			return g.NotFoundHandler(c)
+		}, g.fallbackMiddleware...),
+		methodNotAllowed: wrapMiddleware(func(c Context) error {
+			return g.MethodNotAllowedHandler(c)
+		}, g.fallbackMiddleware...),
+		autoOptions: wrapMiddleware(autoOptionsHandler, g.middleware...),
+	}
+}
+
+func (g *Golam) wrapRoute(handler HandlerFunc) HandlerFunc {
+	return wrapMiddleware(handler, g.middleware...)
+}
+
+// dispatch matches the path and method of c with the router after Pre middleware,
+// so that Pre middleware can rewrite them with Context.SetPath or the method of Context.Request.
+func (g *Golam) dispatch(c Context) error {
+	r := g.router.FindRoute(c.Path())
+	if r == nil {
+		return g.chains.notFound(c)
+	}
+
+	handler, params := g.routeHandler(c, r, c.Request().Method)
+	if handler == nil {
+		c.Response().Header().Set(HeaderAllow, g.allowHeader(r))
+		return g.chains.methodNotAllowed(c)
+	}
+
+	if params != nil && len(*params) > 0 {
+		c.SetPathParams(extractPathParams(c.PathParams(), c.Path(), params))
+	}
+	if ci, ok := c.(*contextImpl); ok && ci.apiGatewayRoute.routeKeyPath != "" && ci.apiGatewayRoute.path == ci.path {
+		g.crossCheckAPIGateway(ci, r)
+	}
+
+	return handler(c)
+}
+
+// crossCheckAPIGateway warns when API Gateway resolved the request differently from the router.
+// catch-all route keys like "$default" and "/{proxy+}" are not compared.
+func (g *Golam) crossCheckAPIGateway(c *contextImpl, r *route) {
+	routeKeyPath := c.apiGatewayRoute.routeKeyPath
+	if routeKeyPath != lambdaDefaultRouteKey && !strings.Contains(routeKeyPath, "+}") &&
+		replaceRootToEmpty(routeKeyPath) != r.apiGatewayPath {
+		c.Logger().Warn("route differs from API Gateway", "route", r.path, "apiGatewayRoute", routeKeyPath)
+	}
+
+	for k, v := range c.apiGatewayRoute.pathParameters {
+		if p, ok := c.pathParams[k]; ok && p.Value != v {
+			c.Logger().Warn("path parameter differs from API Gateway", "key", k, "value", p.Value, "apiGatewayValue", v)
 		}
+	}
+}
 
+// routeHandler resolves the compiled handler of r for method, falling back to the Any handler.
+func (g *Golam) routeHandler(c Context, r *route, method string) (HandlerFunc, *[]routeParam) {
+	if handler := r.chains.getHandler(method); handler != nil {
+		return handler, r.params.getParamsInfo(method)
 	}
 
-	if ctxImpl.handler == nil {
-		ctxImpl.handler = d.golam.NotFoundHandler
-	} else {
-		ctxImpl.handler = wrapMiddleware(ctxImpl.handler, append(d.golam.preMiddleware, d.golam.middleware...)...)
+	if g.AutoHeadOptions {
+		switch method {
+		case http.MethodHead:
+			c.Response().adapter = newResponseHeadAdapter(c.Response().adapter)
+			if handler := r.chains.getHandler(http.MethodGet); handler != nil {
+				return handler, r.params.getParamsInfo(http.MethodGet)
+			}
+		case http.MethodOptions:
+			if r.handlers.getHandler("") == nil && r.handlers.countHandler() > 0 {
+				c.Response().Header().Set(HeaderAllow, g.allowHeader(r))
+				return g.chains.autoOptions, nil
+			}
+		}
 	}
 
-	switch err = ctxImpl.handler(ctxImpl); err {
-	case nil:
-		err = ctxImpl.Response().Commit()
-		if err != nil {
-			//TODO: logging
-			return nil, err
+	return r.chains.getHandler(""), r.params.getParamsInfo("")
+}
+
+// autoOptionsHandler answers OPTIONS with the Allow header set by routeHandler.
+func autoOptionsHandler(c Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (g *Golam) allowHeader(r *route) string {
+	methods := r.handlers.allowedMethods()
+	if g.AutoHeadOptions {
+		if r.handlers.getMethod != nil && r.handlers.headMethod == nil {
+			// allowedMethods always puts GET first.
+			methods = append(methods[:1], append([]string{http.MethodHead}, methods[1:]...)...)
+		}
+
+		if r.handlers.optionsMethod == nil {
+			methods = append(methods, http.MethodOptions)
 		}
-	default:
-		// TODO error handler
 	}
 
-	return json.Marshal(response)
+	return strings.Join(methods, ", ")
 }
 
 func (g *Golam) StartWithLocalAddr(localAddr string) error {
@@ -227,64 +343,123 @@ func (g *Golam) Start() error {
 	return g.start()
 }
 
+// acquireContext returns a context from the pool. contexts must not be used after the request is served,
+// because they are reset and reused by releaseContext.
+func (g *Golam) acquireContext() *contextImpl {
+	return g.pool.Get().(*contextImpl)
+}
+
+func (g *Golam) releaseContext(c *contextImpl) {
+	c.Reset()
+	g.pool.Put(c)
+}
+
 func (g *Golam) Router() Router {
 	return g.router
 }
 
+// Validate reports route conflicts, e.g. to fail a deployment before serving.
+// the returned error is RouteConflicts or nil.
+func (g *Golam) Validate() error {
+	if conflicts := g.router.Conflicts(); len(conflicts) > 0 {
+		return conflicts
+	}
+
+	return nil
+}
+
+func (g *Golam) routeConflict(err *RouteConflictError) {
+	if g.StrictRouting {
+		panic(err)
+	}
+
+	g.Logger.Warn("route conflict", LogKeyError, err)
+}
+
+// Pre adds middleware that runs before routing, for all requests including those without a matching route.
+// a Pre middleware can change the path with Context.SetPath or the method of Context.Request to route the request.
 func (g *Golam) Pre(middleware ...MiddlewareFunc) {
 	g.preMiddleware = append(g.preMiddleware, middleware...)
+	g.compile()
 }
 
+// Use adds middleware that runs after routing, for matched routes and
+// for NotFoundHandler and MethodNotAllowedHandler.
 func (g *Golam) Use(middleware ...MiddlewareFunc) {
 	g.middleware = append(g.middleware, middleware...)
+	g.fallbackMiddleware = append(g.fallbackMiddleware, middleware...)
+	g.compile()
 }
 
-func (g *Golam) Any(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+// UseForRoutes is Use, except that the middleware skips requests without a matching route or method.
+func (g *Golam) UseForRoutes(middleware ...MiddlewareFunc) {
+	g.middleware = append(g.middleware, middleware...)
+	g.compile()
+}
+
+func (g *Golam) Any(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().Any(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: RouteMethodAny, Path: path}
 }
 
-func (g *Golam) GET(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) GET(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().GET(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodGet, Path: path}
 }
 
-func (g *Golam) HEAD(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) HEAD(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().HEAD(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodHead, Path: path}
 }
 
-func (g *Golam) POST(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) POST(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().POST(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodPost, Path: path}
 }
 
-func (g *Golam) PUT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) PUT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().PUT(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodPut, Path: path}
 }
 
-func (g *Golam) PATCH(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) PATCH(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().PATCH(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodPatch, Path: path}
 }
 
-func (g *Golam) DELETE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) DELETE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().DELETE(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodDelete, Path: path}
 }
 
-func (g *Golam) CONNECT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) CONNECT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().CONNECT(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodConnect, Path: path}
 }
 
-func (g *Golam) OPTIONS(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) OPTIONS(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().OPTIONS(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodOptions, Path: path}
 }
 
-func (g *Golam) TRACE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+func (g *Golam) TRACE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
 	g.Router().TRACE(path, handler, middleware...)
+	return &RouteRef{golam: g, Method: http.MethodTrace, Path: path}
 }
 
-const defaultNotFoundResponseData = "{\"message\":\"Not Found\"}"
+const (
+	defaultNotFoundResponseData         = "{\"message\":\"Not Found\"}"
+	defaultMethodNotAllowedResponseData = "{\"message\":\"Method Not Allowed\"}"
+)
 
 func DefaultNotFound(ctx Context) error {
 	return ctx.JSONBytes(http.StatusNotFound, []byte(defaultNotFoundResponseData))
 }
 
+func DefaultMethodNotAllowed(ctx Context) error {
+	return ctx.JSONBytes(http.StatusMethodNotAllowed, []byte(defaultMethodNotAllowedResponseData))
+}
+
 func newHTTPRequestFromAPIGatewayV2HTTPRequest(ctx context.Context, from *events.APIGatewayV2HTTPRequest) (req *http.Request, err error) {
 	rawProtocol := from.RequestContext.HTTP.Protocol
 	protoDiv := strings.Index(rawProtocol, "/")
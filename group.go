@@ -0,0 +1,114 @@
+package golam
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Group registers routes under a shared path prefix and middleware.
+type Group struct {
+	golam      *Golam
+	prefix     string
+	middleware []MiddlewareFunc
+}
+
+func (g *Golam) Group(prefix string, middleware ...MiddlewareFunc) *Group {
+	return &Group{
+		golam:      g,
+		prefix:     joinGroupPath("", prefix),
+		middleware: append([]MiddlewareFunc(nil), middleware...),
+	}
+}
+
+func (gr *Group) Group(prefix string, middleware ...MiddlewareFunc) *Group {
+	m := make([]MiddlewareFunc, 0, len(gr.middleware)+len(middleware))
+	m = append(m, gr.middleware...)
+	m = append(m, middleware...)
+
+	return &Group{
+		golam:      gr.golam,
+		prefix:     joinGroupPath(gr.prefix, prefix),
+		middleware: m,
+	}
+}
+
+// Use adds middleware to routes registered on the group afterwards.
+func (gr *Group) Use(middleware ...MiddlewareFunc) {
+	gr.middleware = append(gr.middleware, middleware...)
+}
+
+func (gr *Group) Prefix() string {
+	return gr.prefix
+}
+
+func (gr *Group) AddRoute(method string, path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	m := make([]MiddlewareFunc, 0, len(gr.middleware)+len(middleware))
+	m = append(m, gr.middleware...)
+	m = append(m, middleware...)
+
+	path = joinGroupPath(gr.prefix, path)
+	gr.golam.Router().AddRoute(method, path, handler, m...)
+	return &RouteRef{golam: gr.golam, Method: routeMethodName(replaceMethodWildcardToBlank(method)), Path: path}
+}
+
+func (gr *Group) DelRoute(method string, path string) {
+	gr.golam.Router().DelRoute(method, joinGroupPath(gr.prefix, path))
+}
+
+func (gr *Group) Any(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute("", path, handler, middleware...)
+}
+
+func (gr *Group) GET(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodGet, path, handler, middleware...)
+}
+
+func (gr *Group) HEAD(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodHead, path, handler, middleware...)
+}
+
+func (gr *Group) POST(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodPost, path, handler, middleware...)
+}
+
+func (gr *Group) PUT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodPut, path, handler, middleware...)
+}
+
+func (gr *Group) PATCH(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodPatch, path, handler, middleware...)
+}
+
+func (gr *Group) DELETE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodDelete, path, handler, middleware...)
+}
+
+func (gr *Group) CONNECT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodConnect, path, handler, middleware...)
+}
+
+func (gr *Group) OPTIONS(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodOptions, path, handler, middleware...)
+}
+
+func (gr *Group) TRACE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) *RouteRef {
+	return gr.AddRoute(http.MethodTrace, path, handler, middleware...)
+}
+
+// joinGroupPath joins prefix and path with a single slash.
+// "" and "/" refer to the prefix itself, because API Gateway route keys have no trailing slash.
+func joinGroupPath(prefix string, path string) string {
+	prefix = strings.TrimRight(prefix, "/")
+	path = strings.Trim(path, "/")
+
+	switch {
+	case path == "" && prefix == "":
+		return "/"
+	case path == "":
+		return prefix
+	case prefix == "":
+		return "/" + path
+	default:
+		return prefix + "/" + path
+	}
+}
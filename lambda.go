@@ -1,6 +1,10 @@
 package golam
 
-import "os"
+import (
+	"encoding/json"
+	"os"
+	"strings"
+)
 
 const (
 	envLambdaServerPort = "_LAMBDA_SERVER_PORT"
@@ -14,3 +18,81 @@ func IsLambdaRuntime() bool {
 func isLambdaRuntime() bool {
 	return os.Getenv(envLambdaServerPort) != "" || os.Getenv(envLambdaRuntimeAPI) != ""
 }
+
+type lambdaEventSource uint8
+
+const (
+	lambdaEventSourceUnknown lambdaEventSource = iota
+	lambdaEventSourceAPIGatewayV1
+	lambdaEventSourceAPIGatewayV2
+	lambdaEventSourceALB
+	lambdaEventSourceFunctionURL
+)
+
+const (
+	lambdaPayloadVersion2 = "2.0"
+
+	lambdaFunctionURLDomainMarker = ".lambda-url."
+
+	lambdaDefaultRouteKey = "$default"
+	lambdaDefaultStage    = "$default"
+)
+
+// lambdaEventProbe has just enough fields to tell event sources apart.
+type lambdaEventProbe struct {
+	Version        string `json:"version"`
+	RouteKey       string `json:"routeKey"`
+	HTTPMethod     string `json:"httpMethod"`
+	RequestContext struct {
+		ELB        *json.RawMessage `json:"elb"`
+		DomainName string           `json:"domainName"`
+	} `json:"requestContext"`
+}
+
+// detectLambdaEventSource reports the event source of payload.
+// payloads that are not recognized are treated as API Gateway payload v2.
+func detectLambdaEventSource(payload []byte) (lambdaEventSource, error) {
+	var probe lambdaEventProbe
+	if err := json.Unmarshal(payload, &probe); err != nil {
+		return lambdaEventSourceUnknown, err
+	}
+
+	switch {
+	case probe.RequestContext.ELB != nil:
+		return lambdaEventSourceALB, nil
+	case probe.Version == lambdaPayloadVersion2 &&
+		(probe.RouteKey == "" || strings.Contains(probe.RequestContext.DomainName, lambdaFunctionURLDomainMarker)):
+		return lambdaEventSourceFunctionURL, nil
+	case probe.Version == lambdaPayloadVersion2:
+		return lambdaEventSourceAPIGatewayV2, nil
+	case probe.HTTPMethod != "":
+		return lambdaEventSourceAPIGatewayV1, nil
+	default:
+		return lambdaEventSourceAPIGatewayV2, nil
+	}
+}
+
+// stripStagePrefix removes the stage name that HTTP APIs put in front of the path of non-default stages.
+// the prefix exists only on the default endpoint, e.g. "abc123.execute-api.us-east-1.amazonaws.com",
+// custom domains map the stage by API mappings without it.
+func stripStagePrefix(path string, stage string, domainName string) string {
+	if stage == "" || stage == lambdaDefaultStage || !isExecuteAPIDomain(domainName) {
+		return path
+	}
+
+	prefix := "/" + stage
+	if path == prefix {
+		return "/"
+	}
+
+	if strings.HasPrefix(path, prefix+"/") {
+		return path[len(prefix):]
+	}
+
+	return path
+}
+
+// isExecuteAPIDomain reports whether the domain is the default endpoint of API Gateway, not a custom domain.
+func isExecuteAPIDomain(domainName string) bool {
+	return strings.Contains(domainName, ".execute-api.")
+}
@@ -0,0 +1,97 @@
+package golam
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aws/aws-lambda-go/events"
+	"net/http"
+	"net/url"
+)
+
+func (d *defaultLambdaHandler) invokeALB(ctx context.Context, payload []byte, coldStart bool) ([]byte, error) {
+	var lReq events.ALBTargetGroupRequest
+	err := json.Unmarshal(payload, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to unmarshal lambda payload", LogKeyError, err)
+		return nil, err
+	}
+
+	req, err := newHTTPRequestFromALBTargetGroupRequest(ctx, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to convert lambda payload to http request", LogKeyError, err)
+		return nil, err
+	}
+
+	var response events.ALBTargetGroupResponse
+	ctxImpl := d.golam.acquireContext()
+	defer d.golam.releaseContext(ctxImpl)
+
+	ctxImpl.request = req
+	// the target group sends and expects multi value headers only when it is enabled.
+	ctxImpl.setResponseAdapter(NewResponseLambdaALBAdapter(&response, len(lReq.MultiValueHeaders) > 0))
+	ctxImpl.path = req.URL.Path
+	ctxImpl.query = req.URL.Query()
+	ctxImpl.primalRequest = &lReq
+	ctxImpl.coldStart = coldStart
+
+	if err = d.golam.servePath(ctxImpl); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(response)
+}
+
+func newHTTPRequestFromALBTargetGroupRequest(ctx context.Context, from *events.ALBTargetGroupRequest) (req *http.Request, err error) {
+	var body []byte
+	if from.IsBase64Encoded {
+		body, err = base64.StdEncoding.DecodeString(from.Body)
+		if err != nil {
+			return
+		}
+	} else {
+		body = []byte(from.Body)
+	}
+
+	header := getHeaderFromMultiValue(from.Headers, from.MultiValueHeaders)
+
+	// ALB passes query parameters as they were sent, without decoding.
+	query := make(url.Values)
+	for k, v := range getQueryFromMultiValue(from.QueryStringParameters, from.MultiValueQueryStringParameters) {
+		k = unescapeQueryOrRaw(k)
+		for _, vv := range v {
+			query.Add(k, unescapeQueryOrRaw(vv))
+		}
+	}
+
+	var rawURL bytes.Buffer
+	rawURL.WriteString(getSchemeFromHeader(header))
+	rawURL.WriteString("://")
+	rawURL.WriteString(header.Get(HeaderHost))
+	rawURL.WriteString(from.Path)
+	if len(query) > 0 {
+		rawURL.WriteByte('?')
+		rawURL.WriteString(query.Encode())
+	}
+
+	req, err = http.NewRequestWithContext(ctx, from.HTTPMethod, rawURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	req.Proto = defaultHTTPProtocol
+	req.ProtoMajor = 1
+	req.ProtoMinor = 1
+	req.Header = header
+	return
+}
+
+func unescapeQueryOrRaw(s string) string {
+	unescaped, err := url.QueryUnescape(s)
+	if err != nil {
+		return s
+	}
+
+	return unescaped
+}
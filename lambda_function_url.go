@@ -0,0 +1,94 @@
+package golam
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aws/aws-lambda-go/events"
+	"net/http"
+	"strings"
+)
+
+func (d *defaultLambdaHandler) invokeFunctionURL(ctx context.Context, payload []byte, coldStart bool) ([]byte, error) {
+	var lReq events.LambdaFunctionURLRequest
+	err := json.Unmarshal(payload, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to unmarshal lambda payload", LogKeyError, err)
+		return nil, err
+	}
+
+	req, err := newHTTPRequestFromLambdaFunctionURLRequest(ctx, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to convert lambda payload to http request", LogKeyError, err, LogKeyRequestID, lReq.RequestContext.RequestID)
+		return nil, err
+	}
+
+	var response events.LambdaFunctionURLResponse
+	ctxImpl := d.golam.acquireContext()
+	defer d.golam.releaseContext(ctxImpl)
+
+	ctxImpl.request = req
+	ctxImpl.setResponseAdapter(NewResponseLambdaFunctionURLAdapter(&response))
+	ctxImpl.path = req.URL.Path
+	ctxImpl.query = req.URL.Query()
+	ctxImpl.primalRequest = &lReq
+	ctxImpl.coldStart = coldStart
+
+	if err = d.golam.servePath(ctxImpl); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(response)
+}
+
+func newHTTPRequestFromLambdaFunctionURLRequest(ctx context.Context, from *events.LambdaFunctionURLRequest) (req *http.Request, err error) {
+	rawProtocol := from.RequestContext.HTTP.Protocol
+	if rawProtocol == "" {
+		rawProtocol = defaultHTTPProtocol
+	}
+
+	major, minor, ok := http.ParseHTTPVersion(rawProtocol)
+	if !ok {
+		major, minor = 1, 1
+	}
+
+	var body []byte
+	if from.IsBase64Encoded {
+		body, err = base64.StdEncoding.DecodeString(from.Body)
+		if err != nil {
+			return
+		}
+	} else {
+		body = []byte(from.Body)
+	}
+
+	header := getHeaderWithCookies(from.Headers, from.Cookies)
+
+	host := header.Get(HeaderHost)
+	if host == "" {
+		host = from.RequestContext.DomainName
+	}
+
+	var rawURL strings.Builder
+	rawURL.WriteString(getSchemeFromHeader(header))
+	rawURL.WriteString("://")
+	rawURL.WriteString(host)
+	rawURL.WriteString(from.RawPath)
+	if len(from.RawQueryString) > 0 {
+		rawURL.WriteRune('?')
+		rawURL.WriteString(from.RawQueryString)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, from.RequestContext.HTTP.Method, rawURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	req.Proto = rawProtocol
+	req.ProtoMajor = major
+	req.ProtoMinor = minor
+	req.RemoteAddr = from.RequestContext.HTTP.SourceIP
+	req.Header = header
+	return
+}
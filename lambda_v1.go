@@ -0,0 +1,90 @@
+package golam
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/aws/aws-lambda-go/events"
+	"net/http"
+	"net/url"
+)
+
+const (
+	defaultHTTPProtocol = "HTTP/1.1"
+)
+
+func (d *defaultLambdaHandler) invokeAPIGatewayV1(ctx context.Context, payload []byte, coldStart bool) ([]byte, error) {
+	var lReq events.APIGatewayProxyRequest
+	err := json.Unmarshal(payload, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to unmarshal lambda payload", LogKeyError, err)
+		return nil, err
+	}
+
+	req, err := newHTTPRequestFromAPIGatewayProxyRequest(ctx, &lReq)
+	if err != nil {
+		d.golam.Logger.Error("failed to convert lambda payload to http request", LogKeyError, err, LogKeyRequestID, lReq.RequestContext.RequestID)
+		return nil, err
+	}
+
+	var response events.APIGatewayProxyResponse
+	ctxImpl := d.golam.acquireContext()
+	defer d.golam.releaseContext(ctxImpl)
+
+	ctxImpl.request = req
+	ctxImpl.setResponseAdapter(NewResponseLambdaV1Adapter(&response))
+	ctxImpl.path = lReq.Path
+	ctxImpl.query = req.URL.Query()
+	ctxImpl.primalRequest = &lReq
+	ctxImpl.coldStart = coldStart
+
+	if err = d.golam.serveAPIGateway(ctxImpl, lReq.Resource, lReq.PathParameters); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(response)
+}
+
+func newHTTPRequestFromAPIGatewayProxyRequest(ctx context.Context, from *events.APIGatewayProxyRequest) (req *http.Request, err error) {
+	rawProtocol := from.RequestContext.Protocol
+	if rawProtocol == "" {
+		rawProtocol = defaultHTTPProtocol
+	}
+
+	major, minor, ok := http.ParseHTTPVersion(rawProtocol)
+	if !ok {
+		major, minor = 1, 1
+	}
+
+	var body []byte
+	if from.IsBase64Encoded {
+		body, err = base64.StdEncoding.DecodeString(from.Body)
+		if err != nil {
+			return
+		}
+	} else {
+		body = []byte(from.Body)
+	}
+
+	header := getHeaderFromMultiValue(from.Headers, from.MultiValueHeaders)
+
+	u := url.URL{
+		Scheme:   getSchemeFromHeader(header),
+		Host:     header.Get(HeaderHost),
+		Path:     from.Path,
+		RawQuery: getQueryFromMultiValue(from.QueryStringParameters, from.MultiValueQueryStringParameters).Encode(),
+	}
+
+	req, err = http.NewRequestWithContext(ctx, from.HTTPMethod, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	req.Proto = rawProtocol
+	req.ProtoMajor = major
+	req.ProtoMinor = minor
+	req.RemoteAddr = from.RequestContext.Identity.SourceIP
+	req.Header = header
+	return
+}
@@ -0,0 +1,163 @@
+package golam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+type LogLevel uint8
+
+const (
+	LogLevelDebug LogLevel = iota + 1
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+	LogLevelOff
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	default:
+		return ""
+	}
+}
+
+const (
+	LogKeyTime         = "time"
+	LogKeyLevel        = "level"
+	LogKeyMessage      = "msg"
+	LogKeyError        = "error"
+	LogKeyStack        = "stack"
+	LogKeyRequestID    = "requestId"
+	LogKeyRouteKey     = "routeKey"
+	LogKeyTraceID      = "traceId"
+	LogKeyAWSRequestID = "awsRequestId"
+	LogKeyColdStart    = "coldStart"
+)
+
+// Logger is a leveled, structured logger.
+// keyvals are alternating key, value pairs.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+
+	Info(msg string, keyvals ...interface{})
+
+	Warn(msg string, keyvals ...interface{})
+
+	Error(msg string, keyvals ...interface{})
+
+	// With returns a Logger that adds keyvals to every entry.
+	With(keyvals ...interface{}) Logger
+}
+
+func NewJSONLogger(w io.Writer, level LogLevel) Logger {
+	return &jsonLogger{
+		out: &jsonLoggerOutput{
+			writer: w,
+		},
+		level: level,
+	}
+}
+
+var _ Logger = (*jsonLogger)(nil)
+
+type jsonLoggerOutput struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+type jsonLogger struct {
+	out     *jsonLoggerOutput
+	level   LogLevel
+	keyvals []interface{}
+}
+
+func (l *jsonLogger) Debug(msg string, keyvals ...interface{}) {
+	l.log(LogLevelDebug, msg, keyvals)
+}
+
+func (l *jsonLogger) Info(msg string, keyvals ...interface{}) {
+	l.log(LogLevelInfo, msg, keyvals)
+}
+
+func (l *jsonLogger) Warn(msg string, keyvals ...interface{}) {
+	l.log(LogLevelWarn, msg, keyvals)
+}
+
+func (l *jsonLogger) Error(msg string, keyvals ...interface{}) {
+	l.log(LogLevelError, msg, keyvals)
+}
+
+func (l *jsonLogger) With(keyvals ...interface{}) Logger {
+	merged := make([]interface{}, 0, len(l.keyvals)+len(keyvals))
+	merged = append(merged, l.keyvals...)
+	merged = append(merged, keyvals...)
+
+	return &jsonLogger{
+		out:     l.out,
+		level:   l.level,
+		keyvals: merged,
+	}
+}
+
+func (l *jsonLogger) log(level LogLevel, msg string, keyvals []interface{}) {
+	if level < l.level {
+		return
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	writeJSONLogField(&buf, LogKeyTime, time.Now().UTC().Format(time.RFC3339Nano))
+	buf.WriteByte(',')
+	writeJSONLogField(&buf, LogKeyLevel, level.String())
+	buf.WriteByte(',')
+	writeJSONLogField(&buf, LogKeyMessage, msg)
+	writeJSONLogFields(&buf, l.keyvals)
+	writeJSONLogFields(&buf, keyvals)
+	buf.WriteString("}\n")
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	_, _ = l.out.writer.Write(buf.Bytes())
+}
+
+func writeJSONLogFields(buf *bytes.Buffer, keyvals []interface{}) {
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		var val interface{} = "!MISSING"
+		if i+1 < len(keyvals) {
+			val = keyvals[i+1]
+		}
+
+		buf.WriteByte(',')
+		writeJSONLogField(buf, key, val)
+	}
+}
+
+func writeJSONLogField(buf *bytes.Buffer, key string, val interface{}) {
+	if err, ok := val.(error); ok {
+		val = err.Error()
+	}
+
+	k, _ := json.Marshal(key)
+	v, err := json.Marshal(val)
+	if err != nil {
+		v, _ = json.Marshal(fmt.Sprint(val))
+	}
+
+	buf.Write(k)
+	buf.WriteByte(':')
+	buf.Write(v)
+}
@@ -0,0 +1,187 @@
+package golam
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type CORSConfig struct {
+	// AllowOrigins is a list of origins that may access the resource.
+	// an origin can be exact ("https://example.com"), "*",
+	// or contain wildcards ("https://*.example.com").
+	// default: ["*"]
+	AllowOrigins []string
+
+	// AllowOriginFunc decides the origin instead of AllowOrigins when set.
+	AllowOriginFunc func(origin string) (bool, error)
+
+	// default: GET, HEAD, PUT, PATCH, POST, DELETE
+	AllowMethods []string
+
+	// AllowHeaders is sent on preflight responses.
+	// when empty, Access-Control-Request-Headers of the request is echoed back.
+	AllowHeaders []string
+
+	// AllowCredentials sends Access-Control-Allow-Credentials.
+	// with the "*" origin, "Access-Control-Allow-Origin: *" is still sent,
+	// which browsers reject for credentialed requests. list the trusted origins instead.
+	AllowCredentials bool
+
+	// UnsafeWildcardOriginWithAllowCredentials echoes the request origin for the "*" origin
+	// when AllowCredentials is set. it lets any website make credentialed requests,
+	// so use it only when the resource is not protected by cookies or other credentials.
+	UnsafeWildcardOriginWithAllowCredentials bool
+
+	ExposeHeaders []string
+
+	// MaxAge is seconds a preflight response may be cached.
+	// 0 omits the header, negative sends "0".
+	MaxAge int
+}
+
+var DefaultCORSConfig = CORSConfig{
+	AllowOrigins: []string{"*"},
+	AllowMethods: []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodPost,
+		http.MethodDelete,
+	},
+}
+
+func CORS() MiddlewareFunc {
+	return CORSWithConfig(DefaultCORSConfig)
+}
+
+// CORSWithConfig returns a CORS middleware.
+// preflight requests are answered with 204 No Content without calling the next handler.
+// preflight requests reach the middleware even when no OPTIONS route is registered,
+// e.g. when API Gateway forwards them to the $default route.
+func CORSWithConfig(config CORSConfig) MiddlewareFunc {
+	if len(config.AllowOrigins) == 0 {
+		config.AllowOrigins = DefaultCORSConfig.AllowOrigins
+	}
+
+	if len(config.AllowMethods) == 0 {
+		config.AllowMethods = DefaultCORSConfig.AllowMethods
+	}
+
+	var patterns []*regexp.Regexp
+	for _, origin := range config.AllowOrigins {
+		if origin == "*" || !strings.Contains(origin, "*") {
+			continue
+		}
+
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.ToLower(origin)), "\\*", "[^/]*") + "$"
+		patterns = append(patterns, regexp.MustCompile(pattern))
+	}
+
+	allowMethods := strings.Join(config.AllowMethods, ",")
+	allowHeaders := strings.Join(config.AllowHeaders, ",")
+	exposeHeaders := strings.Join(config.ExposeHeaders, ",")
+
+	maxAge := ""
+	if config.MaxAge > 0 {
+		maxAge = strconv.Itoa(config.MaxAge)
+	} else if config.MaxAge < 0 {
+		maxAge = "0"
+	}
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(c Context) error {
+			req := c.Request()
+			header := c.Response().Header()
+			origin := req.Header.Get(HeaderOrigin)
+			preflight := isPreflightRequest(req)
+
+			header.Add(HeaderVary, HeaderOrigin)
+			if preflight {
+				header.Add(HeaderVary, HeaderAccessControlRequestMethod)
+				header.Add(HeaderVary, HeaderAccessControlRequestHeaders)
+			}
+
+			allowOrigin := ""
+			if origin != "" {
+				var err error
+				allowOrigin, err = matchCORSOrigin(config, patterns, origin)
+				if err != nil {
+					return err
+				}
+			}
+
+			if allowOrigin == "" {
+				if preflight {
+					return c.NoContent(http.StatusNoContent)
+				}
+				return next(c)
+			}
+
+			header.Set(HeaderAccessControlAllowOrigin, allowOrigin)
+			if config.AllowCredentials {
+				header.Set(HeaderAccessControlAllowCredentials, "true")
+			}
+
+			if !preflight {
+				if exposeHeaders != "" {
+					header.Set(HeaderAccessControlExposeHeaders, exposeHeaders)
+				}
+				return next(c)
+			}
+
+			header.Set(HeaderAccessControlAllowMethods, allowMethods)
+			if allowHeaders != "" {
+				header.Set(HeaderAccessControlAllowHeaders, allowHeaders)
+			} else if h := req.Header.Get(HeaderAccessControlRequestHeaders); h != "" {
+				header.Set(HeaderAccessControlAllowHeaders, h)
+			}
+
+			if maxAge != "" {
+				header.Set(HeaderAccessControlMaxAge, maxAge)
+			}
+
+			return c.NoContent(http.StatusNoContent)
+		}
+	}
+}
+
+func matchCORSOrigin(config CORSConfig, patterns []*regexp.Regexp, origin string) (string, error) {
+	if config.AllowOriginFunc != nil {
+		ok, err := config.AllowOriginFunc(origin)
+		if err != nil || !ok {
+			return "", err
+		}
+		return origin, nil
+	}
+
+	for _, o := range config.AllowOrigins {
+		if o == "*" {
+			if config.AllowCredentials && config.UnsafeWildcardOriginWithAllowCredentials {
+				return origin, nil
+			}
+			return "*", nil
+		}
+
+		if strings.EqualFold(o, origin) {
+			return origin, nil
+		}
+	}
+
+	lowerOrigin := strings.ToLower(origin)
+	for _, p := range patterns {
+		if p.MatchString(lowerOrigin) {
+			return origin, nil
+		}
+	}
+
+	return "", nil
+}
+
+func isPreflightRequest(r *http.Request) bool {
+	return r.Method == http.MethodOptions &&
+		r.Header.Get(HeaderOrigin) != "" &&
+		r.Header.Get(HeaderAccessControlRequestMethod) != ""
+}
@@ -0,0 +1,61 @@
+package golam
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *discardResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *discardResponseWriter) WriteHeader(int) {}
+
+func noopMiddleware(next HandlerFunc) HandlerFunc {
+	return func(c Context) error {
+		return next(c)
+	}
+}
+
+func benchmarkServeStatic(b *testing.B, g *Golam) {
+	w := &discardResponseWriter{header: make(http.Header)}
+	r := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.LocalHandler.ServeHTTP(w, r)
+	}
+}
+
+// BenchmarkServeStatic is the baseline of BenchmarkServeStaticWithMiddleware.
+func BenchmarkServeStatic(b *testing.B) {
+	g := New()
+	g.GET("/users/me", func(c Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	benchmarkServeStatic(b, g)
+}
+
+// BenchmarkServeStaticWithMiddleware shows that compiled middleware chains add no allocations per request.
+func BenchmarkServeStaticWithMiddleware(b *testing.B) {
+	g := New()
+	g.Pre(noopMiddleware)
+	g.Use(noopMiddleware, noopMiddleware)
+	g.UseForRoutes(noopMiddleware)
+	g.GET("/users/me", func(c Context) error {
+		return c.NoContent(http.StatusNoContent)
+	}, noopMiddleware, noopMiddleware)
+
+	benchmarkServeStatic(b, g)
+}
@@ -1,10 +1,22 @@
 package golam
 
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+)
+
 type PathParam struct {
 	Key   string
 	Value string
 }
 
+// PathParams is path parameters of a request by name.
+// the map of Context.PathParams is cleared when the Context returns to the pool,
+// so it must be copied to be used after the handler returns.
 type PathParams map[string]PathParam
 
 func (p PathParams) Get(key string) string {
@@ -20,4 +32,359 @@ func (p PathParams) Set(key string, param PathParam) {
 	p[key] = param
 }
 
-// TODO 바인딩 추가 필요
+func (p PathParams) value(key string) paramValue {
+	return paramValue{source: paramSourcePath, key: key, value: p.Get(key)}
+}
+
+// Int returns the path parameter as int.
+// a missing or unconvertible value is a 400 HTTPError naming the parameter.
+func (p PathParams) Int(key string) (int, error) {
+	return p.value(key).int()
+}
+
+func (p PathParams) Int64(key string) (int64, error) {
+	return p.value(key).int64()
+}
+
+func (p PathParams) Uint(key string) (uint, error) {
+	return p.value(key).uint()
+}
+
+func (p PathParams) Float(key string) (float64, error) {
+	return p.value(key).float()
+}
+
+func (p PathParams) Bool(key string) (bool, error) {
+	return p.value(key).bool()
+}
+
+func (p PathParams) Time(key string, layout string) (time.Time, error) {
+	return p.value(key).time(layout)
+}
+
+func (p PathParams) Duration(key string) (time.Duration, error) {
+	return p.value(key).duration()
+}
+
+// UUID returns the path parameter after checking it is a UUID in the canonical 8-4-4-4-12 form.
+func (p PathParams) UUID(key string) (string, error) {
+	return p.value(key).uuid()
+}
+
+// IntOr returns def when the path parameter is missing. an unconvertible value is still an error.
+func (p PathParams) IntOr(key string, def int) (int, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.int()
+}
+
+func (p PathParams) Int64Or(key string, def int64) (int64, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.int64()
+}
+
+func (p PathParams) UintOr(key string, def uint) (uint, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.uint()
+}
+
+func (p PathParams) FloatOr(key string, def float64) (float64, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.float()
+}
+
+func (p PathParams) BoolOr(key string, def bool) (bool, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.bool()
+}
+
+func (p PathParams) TimeOr(key string, layout string, def time.Time) (time.Time, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.time(layout)
+}
+
+func (p PathParams) DurationOr(key string, def time.Duration) (time.Duration, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.duration()
+}
+
+func (p PathParams) UUIDOr(key string, def string) (string, error) {
+	v := p.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.uuid()
+}
+
+// QueryValues is url.Values with typed getters.
+// single value getters use the first value, slice getters convert every value of the key.
+type QueryValues url.Values
+
+func (q QueryValues) Get(key string) string {
+	return url.Values(q).Get(key)
+}
+
+func (q QueryValues) value(key string) paramValue {
+	return paramValue{source: paramSourceQuery, key: key, value: q.Get(key)}
+}
+
+func (q QueryValues) values(key string) []paramValue {
+	values := make([]paramValue, len(q[key]))
+	for i, v := range q[key] {
+		values[i] = paramValue{source: paramSourceQuery, key: key, value: v}
+	}
+	return values
+}
+
+func (q QueryValues) Int(key string) (int, error) {
+	return q.value(key).int()
+}
+
+func (q QueryValues) Int64(key string) (int64, error) {
+	return q.value(key).int64()
+}
+
+func (q QueryValues) Uint(key string) (uint, error) {
+	return q.value(key).uint()
+}
+
+func (q QueryValues) Float(key string) (float64, error) {
+	return q.value(key).float()
+}
+
+func (q QueryValues) Bool(key string) (bool, error) {
+	return q.value(key).bool()
+}
+
+func (q QueryValues) Time(key string, layout string) (time.Time, error) {
+	return q.value(key).time(layout)
+}
+
+func (q QueryValues) Duration(key string) (time.Duration, error) {
+	return q.value(key).duration()
+}
+
+func (q QueryValues) UUID(key string) (string, error) {
+	return q.value(key).uuid()
+}
+
+// IntOr returns def when the query parameter is missing or empty. an unconvertible value is still an error.
+func (q QueryValues) IntOr(key string, def int) (int, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.int()
+}
+
+func (q QueryValues) Int64Or(key string, def int64) (int64, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.int64()
+}
+
+func (q QueryValues) UintOr(key string, def uint) (uint, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.uint()
+}
+
+func (q QueryValues) FloatOr(key string, def float64) (float64, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.float()
+}
+
+func (q QueryValues) BoolOr(key string, def bool) (bool, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.bool()
+}
+
+func (q QueryValues) TimeOr(key string, layout string, def time.Time) (time.Time, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.time(layout)
+}
+
+func (q QueryValues) DurationOr(key string, def time.Duration) (time.Duration, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.duration()
+}
+
+func (q QueryValues) UUIDOr(key string, def string) (string, error) {
+	v := q.value(key)
+	if v.missing() {
+		return def, nil
+	}
+	return v.uuid()
+}
+
+// Ints converts every value of the query parameter, e.g. "?id=1&id=2".
+// a missing parameter is an empty slice.
+func (q QueryValues) Ints(key string) ([]int, error) {
+	return convertAll(q.values(key), paramValue.int)
+}
+
+func (q QueryValues) Int64s(key string) ([]int64, error) {
+	return convertAll(q.values(key), paramValue.int64)
+}
+
+func (q QueryValues) Uints(key string) ([]uint, error) {
+	return convertAll(q.values(key), paramValue.uint)
+}
+
+func (q QueryValues) Floats(key string) ([]float64, error) {
+	return convertAll(q.values(key), paramValue.float)
+}
+
+func (q QueryValues) Bools(key string) ([]bool, error) {
+	return convertAll(q.values(key), paramValue.bool)
+}
+
+func (q QueryValues) Times(key string, layout string) ([]time.Time, error) {
+	return convertAll(q.values(key), func(v paramValue) (time.Time, error) { return v.time(layout) })
+}
+
+func (q QueryValues) Durations(key string) ([]time.Duration, error) {
+	return convertAll(q.values(key), paramValue.duration)
+}
+
+func (q QueryValues) UUIDs(key string) ([]string, error) {
+	return convertAll(q.values(key), paramValue.uuid)
+}
+
+const (
+	paramSourcePath  = "path"
+	paramSourceQuery = "query"
+)
+
+var uuidRegexp = regexp.MustCompile("^" + segmentParamTypes["uuid"] + "$")
+
+// convertAll converts every value with convert, stopping at the first error.
+func convertAll[T any](values []paramValue, convert func(v paramValue) (T, error)) ([]T, error) {
+	result := make([]T, len(values))
+	for i, v := range values {
+		var err error
+		if result[i], err = convert(v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// paramValue is one path or query parameter value to convert.
+type paramValue struct {
+	source string
+	key    string
+	value  string
+}
+
+func (v paramValue) missing() bool {
+	return v.value == ""
+}
+
+// invalid returns a 400 HTTPError naming the parameter, e.g. `invalid path parameter "id": expected int`.
+func (v paramValue) invalid(typ string, err error) error {
+	if v.missing() {
+		return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("missing %s parameter %q", v.source, v.key))
+	}
+
+	return NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid %s parameter %q: expected %s", v.source, v.key, typ)).SetInternal(err)
+}
+
+func (v paramValue) int() (int, error) {
+	i, err := strconv.Atoi(v.value)
+	if err != nil {
+		return 0, v.invalid("int", err)
+	}
+	return i, nil
+}
+
+func (v paramValue) int64() (int64, error) {
+	i, err := strconv.ParseInt(v.value, 10, 64)
+	if err != nil {
+		return 0, v.invalid("int64", err)
+	}
+	return i, nil
+}
+
+func (v paramValue) uint() (uint, error) {
+	u, err := strconv.ParseUint(v.value, 10, 0)
+	if err != nil {
+		return 0, v.invalid("uint", err)
+	}
+	return uint(u), nil
+}
+
+func (v paramValue) float() (float64, error) {
+	f, err := strconv.ParseFloat(v.value, 64)
+	if err != nil {
+		return 0, v.invalid("float", err)
+	}
+	return f, nil
+}
+
+func (v paramValue) bool() (bool, error) {
+	b, err := strconv.ParseBool(v.value)
+	if err != nil {
+		return false, v.invalid("bool", err)
+	}
+	return b, nil
+}
+
+func (v paramValue) time(layout string) (time.Time, error) {
+	t, err := time.Parse(layout, v.value)
+	if err != nil {
+		return time.Time{}, v.invalid("time in layout "+strconv.Quote(layout), err)
+	}
+	return t, nil
+}
+
+func (v paramValue) duration() (time.Duration, error) {
+	d, err := time.ParseDuration(v.value)
+	if err != nil {
+		return 0, v.invalid("duration", err)
+	}
+	return d, nil
+}
+
+func (v paramValue) uuid() (string, error) {
+	if !uuidRegexp.MatchString(v.value) {
+		return "", v.invalid("uuid", nil)
+	}
+	return v.value, nil
+}
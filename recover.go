@@ -0,0 +1,89 @@
+package golam
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+)
+
+const (
+	defaultRecoverStackSize = 4 << 10 // 4 KB
+)
+
+type (
+	RecoverConfig struct {
+		// Disable turns off panic recovery in ServeHTTP and Invoke.
+		Disable bool
+
+		// StackSize is size of the captured stack. default: 4 KB
+		StackSize int
+
+		// DisableStackAll captures only the panicking goroutine's stack.
+		DisableStackAll bool
+
+		// DisableStack skips stack capture.
+		DisableStack bool
+	}
+
+	PanicError struct {
+		Recovered interface{}
+		Stack     []byte
+	}
+)
+
+var _ error = (*PanicError)(nil)
+
+func (pe *PanicError) Error() string {
+	return fmt.Sprintf("[PANIC RECOVER] %v", pe.Recovered)
+}
+
+func (pe *PanicError) Unwrap() error {
+	if err, ok := pe.Recovered.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Golam) handle(c *contextImpl) {
+	if !g.Recover.Disable {
+		defer g.recover(c)
+	}
+
+	if err := c.handler(c); err != nil {
+		g.HTTPErrorHandler(err, c)
+	}
+}
+
+func (g *Golam) recover(c Context) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if r == http.ErrAbortHandler {
+		panic(r)
+	}
+
+	pe := &PanicError{
+		Recovered: r,
+	}
+
+	if !g.Recover.DisableStack {
+		stackSize := g.Recover.StackSize
+		if stackSize <= 0 {
+			stackSize = defaultRecoverStackSize
+		}
+
+		stack := make([]byte, stackSize)
+		pe.Stack = stack[:runtime.Stack(stack, !g.Recover.DisableStackAll)]
+	}
+
+	c.Logger().Error("panic recovered", LogKeyError, pe, LogKeyStack, string(pe.Stack))
+
+	if c.Response().Written {
+		return
+	}
+
+	g.HTTPErrorHandler(pe, c)
+}
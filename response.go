@@ -15,6 +15,12 @@ func NewResponse(adapter ResponseAdapter) *Response {
 type Response struct {
 	adapter   ResponseAdapter
 	Committed bool
+
+	// Written reports whether the status or the body has been written.
+	// a response can not be replaced after that, e.g. by the HTTP error handler.
+	Written bool
+	Status  int
+	Size    int64
 }
 
 func (r *Response) Header() http.Header {
@@ -22,10 +28,23 @@ func (r *Response) Header() http.Header {
 }
 
 func (r *Response) Write(b []byte) (int, error) {
-	return r.adapter.Write(b)
+	if !r.Written {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	n, err := r.adapter.Write(b)
+	r.Size += int64(n)
+	return n, err
 }
 
+// WriteHeader writes the status once, later calls are ignored.
 func (r *Response) WriteHeader(statusCode int) {
+	if r.Written {
+		return
+	}
+
+	r.Written = true
+	r.Status = statusCode
 	r.adapter.WriteHeader(statusCode)
 }
 
@@ -33,8 +52,9 @@ func (r *Response) SetCookie(cookie *http.Cookie) {
 	r.adapter.SetCookie(cookie)
 }
 
+// ResponseWriter returns r as http.ResponseWriter, so that writes through it are tracked.
 func (r *Response) ResponseWriter() http.ResponseWriter {
-	return r.adapter
+	return r
 }
 
 func (r *Response) Commit() error {
@@ -0,0 +1,47 @@
+package golam
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var _ ResponseAdapter = (*responseHeadAdapter)(nil)
+
+// responseHeadAdapter drops the body of a HEAD response
+// and reports its length as Content-Length on commit.
+type responseHeadAdapter struct {
+	ResponseAdapter
+	statusCode int
+	size       int
+}
+
+func newResponseHeadAdapter(adapter ResponseAdapter) ResponseAdapter {
+	return &responseHeadAdapter{
+		ResponseAdapter: adapter,
+	}
+}
+
+func (w *responseHeadAdapter) Write(bytes []byte) (int, error) {
+	w.size += len(bytes)
+	return len(bytes), nil
+}
+
+func (w *responseHeadAdapter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+func (w *responseHeadAdapter) Commit() error {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	header := w.Header()
+	if header.Get(HeaderContentLength) == "" && w.statusCode != http.StatusNoContent && w.statusCode != http.StatusNotModified {
+		header.Set(HeaderContentLength, strconv.Itoa(w.size))
+	}
+
+	w.ResponseAdapter.WriteHeader(w.statusCode)
+	return w.ResponseAdapter.Commit()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 var _ ResponseAdapter = (*responseLambdaAdapter)(nil)
@@ -70,8 +71,7 @@ func (w *responseLambdaAdapter) commit() error {
 	}
 
 	w.response.Headers = make(map[string]string)
-	w.response.MultiValueHeaders = make(map[string][]string)
-	
+
 	for k, v := range w.header {
 		// known-headers
 		switch k {
@@ -80,18 +80,18 @@ func (w *responseLambdaAdapter) commit() error {
 			continue
 		}
 
-		if len(v) == 1 {
-			w.response.Headers[k] = v[0]
-		} else {
-			w.response.MultiValueHeaders[k] = v
-		}
+		// payload v2 has no multi value headers, so values are joined with commas.
+		w.response.Headers[k] = strings.Join(v, ",")
 	}
 
 	return nil
 }
 
 func (w *responseLambdaAdapter) isBinary() bool {
-	contentType := w.header.Get(HeaderContentType)
+	return isBinaryContentType(w.header.Get(HeaderContentType))
+}
+
+func isBinaryContentType(contentType string) bool {
 	if len(contentType) == 0 {
 		return false
 	}
@@ -0,0 +1,84 @@
+package golam
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/aws/aws-lambda-go/events"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+var _ ResponseAdapter = (*responseLambdaALBAdapter)(nil)
+
+func NewResponseLambdaALBAdapter(response *events.ALBTargetGroupResponse, multiValueHeaders bool) ResponseAdapter {
+	return &responseLambdaALBAdapter{
+		header:            make(http.Header),
+		response:          response,
+		multiValueHeaders: multiValueHeaders,
+	}
+}
+
+type responseLambdaALBAdapter struct {
+	header            http.Header
+	buffer            bytes.Buffer
+	response          *events.ALBTargetGroupResponse
+	multiValueHeaders bool
+}
+
+func (w *responseLambdaALBAdapter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseLambdaALBAdapter) Write(bytes []byte) (int, error) {
+	return w.buffer.Write(bytes)
+}
+
+func (w *responseLambdaALBAdapter) WriteHeader(statusCode int) {
+	w.response.StatusCode = statusCode
+}
+
+func (w *responseLambdaALBAdapter) SetCookie(cookie *http.Cookie) {
+	v := cookie.String()
+	if len(v) == 0 {
+		return
+	}
+
+	w.header.Add(HeaderSetCookie, v)
+}
+
+func (w *responseLambdaALBAdapter) Commit() error {
+	if w.response.StatusCode == 0 {
+		w.response.StatusCode = http.StatusOK
+	}
+
+	w.response.StatusDescription = strconv.Itoa(w.response.StatusCode) + " " + http.StatusText(w.response.StatusCode)
+
+	body, err := ioutil.ReadAll(&w.buffer)
+	if err != nil {
+		return err
+	}
+
+	w.response.IsBase64Encoded = isBinaryContentType(w.header.Get(HeaderContentType))
+	if w.response.IsBase64Encoded {
+		w.response.Body = base64.StdEncoding.EncodeToString(body)
+	} else {
+		w.response.Body = string(body)
+	}
+
+	if w.multiValueHeaders {
+		w.response.MultiValueHeaders = make(map[string][]string)
+		for k, v := range w.header {
+			w.response.MultiValueHeaders[k] = v
+		}
+		return nil
+	}
+
+	// without multi value headers, only the last value of a header is kept.
+	w.response.Headers = make(map[string]string)
+	for k, v := range w.header {
+		w.response.Headers[k] = v[len(v)-1]
+	}
+
+	return nil
+}
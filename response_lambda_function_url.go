@@ -0,0 +1,77 @@
+package golam
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/aws/aws-lambda-go/events"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+var _ ResponseAdapter = (*responseLambdaFunctionURLAdapter)(nil)
+
+func NewResponseLambdaFunctionURLAdapter(response *events.LambdaFunctionURLResponse) ResponseAdapter {
+	return &responseLambdaFunctionURLAdapter{
+		header:   make(http.Header),
+		response: response,
+	}
+}
+
+type responseLambdaFunctionURLAdapter struct {
+	header   http.Header
+	buffer   bytes.Buffer
+	response *events.LambdaFunctionURLResponse
+}
+
+func (w *responseLambdaFunctionURLAdapter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseLambdaFunctionURLAdapter) Write(bytes []byte) (int, error) {
+	return w.buffer.Write(bytes)
+}
+
+func (w *responseLambdaFunctionURLAdapter) WriteHeader(statusCode int) {
+	w.response.StatusCode = statusCode
+}
+
+func (w *responseLambdaFunctionURLAdapter) SetCookie(cookie *http.Cookie) {
+	v := cookie.String()
+	if len(v) == 0 {
+		return
+	}
+
+	w.response.Cookies = append(w.response.Cookies, v)
+}
+
+func (w *responseLambdaFunctionURLAdapter) Commit() error {
+	if w.response.StatusCode == 0 {
+		w.response.StatusCode = http.StatusOK
+	}
+
+	body, err := ioutil.ReadAll(&w.buffer)
+	if err != nil {
+		return err
+	}
+
+	w.response.IsBase64Encoded = isBinaryContentType(w.header.Get(HeaderContentType))
+	if w.response.IsBase64Encoded {
+		w.response.Body = base64.StdEncoding.EncodeToString(body)
+	} else {
+		w.response.Body = string(body)
+	}
+
+	w.response.Headers = make(map[string]string)
+	for k, v := range w.header {
+		if k == HeaderSetCookie {
+			w.response.Cookies = append(w.response.Cookies, v...)
+			continue
+		}
+
+		// function URL responses have no multi value headers, so values are joined with commas.
+		w.response.Headers[k] = strings.Join(v, ",")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package golam
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/aws/aws-lambda-go/events"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ ResponseAdapter = (*responseLambdaV1Adapter)(nil)
+
+func NewResponseLambdaV1Adapter(response *events.APIGatewayProxyResponse) ResponseAdapter {
+	return &responseLambdaV1Adapter{
+		header:   make(http.Header),
+		response: response,
+	}
+}
+
+type responseLambdaV1Adapter struct {
+	header   http.Header
+	buffer   bytes.Buffer
+	response *events.APIGatewayProxyResponse
+}
+
+func (w *responseLambdaV1Adapter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseLambdaV1Adapter) Write(bytes []byte) (int, error) {
+	return w.buffer.Write(bytes)
+}
+
+func (w *responseLambdaV1Adapter) WriteHeader(statusCode int) {
+	w.response.StatusCode = statusCode
+}
+
+func (w *responseLambdaV1Adapter) SetCookie(cookie *http.Cookie) {
+	v := cookie.String()
+	if len(v) == 0 {
+		return
+	}
+
+	w.header.Add(HeaderSetCookie, v)
+}
+
+func (w *responseLambdaV1Adapter) Commit() error {
+	if w.response.StatusCode == 0 {
+		w.response.StatusCode = http.StatusOK
+	}
+
+	body, err := ioutil.ReadAll(&w.buffer)
+	if err != nil {
+		return err
+	}
+
+	w.response.IsBase64Encoded = isBinaryContentType(w.header.Get(HeaderContentType))
+	if w.response.IsBase64Encoded {
+		w.response.Body = base64.StdEncoding.EncodeToString(body)
+	} else {
+		w.response.Body = string(body)
+	}
+
+	// API Gateway merges Headers and MultiValueHeaders, preferring MultiValueHeaders.
+	w.response.Headers = make(map[string]string)
+	w.response.MultiValueHeaders = make(map[string][]string)
+	for k, v := range w.header {
+		if len(v) == 1 {
+			w.response.Headers[k] = v[0]
+		}
+		w.response.MultiValueHeaders[k] = v
+	}
+
+	return nil
+}
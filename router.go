@@ -2,6 +2,7 @@ package golam
 
 import (
 	"net/http"
+	"sort"
 	"strings"
 )
 
@@ -12,6 +13,16 @@ type Router interface {
 
 	DelRoute(method string, path string)
 
+	// Routes returns registered routes in the order of the route tree.
+	Routes() []RouteInfo
+
+	// Compile wraps every route handler with wrap, and keeps wrap for routes added later.
+	// Golam compiles global middleware into route handlers with it.
+	Compile(wrap func(handler HandlerFunc) HandlerFunc)
+
+	// Conflicts returns route conflicts found by AddRoute, except those of deleted routes.
+	Conflicts() RouteConflicts
+
 	Any(path string, handler HandlerFunc, middleware ...MiddlewareFunc)
 
 	GET(path string, handler HandlerFunc, middleware ...MiddlewareFunc)
@@ -37,12 +48,63 @@ type routeParam struct {
 	index    int
 	key      string
 	isGreedy bool
+
+	// pattern extracts the value from a segment with literal text or a typed path variable.
+	pattern *segmentPattern
+	capture int
 }
 
 type route struct {
-	path     string
+	path string
+
+	// apiGatewayPath is path without constraints of path variables, to compare with API Gateway route keys.
+	apiGatewayPath string
+
 	params   paramMethods
 	handlers handlerMethods
+	meta     map[string]routeMeta
+
+	// chains is handlers wrapped with global middleware by Router.Compile.
+	chains handlerMethods
+}
+
+// routeMeta is registration info of a route method, kept for introspection only.
+type routeMeta struct {
+	handler    string
+	middleware int
+}
+
+// routeInfos returns RouteInfo of each method of r, the Any handler first.
+func (r *route) routeInfos() []RouteInfo {
+	methods := r.handlers.allowedMethods()
+	if r.handlers.anyMethod != nil {
+		methods = append([]string{""}, methods...)
+	}
+
+	path := r.path
+	if path == "" {
+		path = "/"
+	}
+
+	infos := make([]RouteInfo, 0, len(methods))
+	for _, method := range methods {
+		info := RouteInfo{
+			Method:     routeMethodName(method),
+			Path:       path,
+			Handler:    r.meta[method].handler,
+			Middleware: r.meta[method].middleware,
+		}
+
+		if params := r.params.getParamsInfo(method); params != nil {
+			for _, p := range *params {
+				info.Params = append(info.Params, p.key)
+			}
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
 }
 
 type paramMethods struct {
@@ -109,6 +171,9 @@ func (h *paramMethods) setParamsInfo(method string, params []routeParam) {
 	case http.MethodTrace:
 		h.traceMethod = &params
 	default:
+		if h.others == nil {
+			h.others = make(map[string]*[]routeParam)
+		}
 		h.others[method] = &params
 	}
 }
@@ -229,6 +294,9 @@ func (h *handlerMethods) setHandler(method string, handler HandlerFunc) {
 	case http.MethodTrace:
 		h.traceMethod = handler
 	default:
+		if h.others == nil {
+			h.others = make(map[string]HandlerFunc)
+		}
 		h.others[method] = handler
 	}
 }
@@ -275,6 +343,36 @@ func (h *handlerMethods) countHandler() int {
 	) + len(h.others)
 }
 
+// allowedMethods returns methods that have a handler, in the order of handlerMethods fields.
+func (h *handlerMethods) allowedMethods() (methods []string) {
+	for _, m := range []struct {
+		method  string
+		handler HandlerFunc
+	}{
+		{http.MethodGet, h.getMethod},
+		{http.MethodHead, h.headMethod},
+		{http.MethodPost, h.postMethod},
+		{http.MethodPut, h.putMethod},
+		{http.MethodPatch, h.patchMethod},
+		{http.MethodDelete, h.deleteMethod},
+		{http.MethodConnect, h.connectMethod},
+		{http.MethodOptions, h.optionsMethod},
+		{http.MethodTrace, h.traceMethod},
+	} {
+		if m.handler != nil {
+			methods = append(methods, m.method)
+		}
+	}
+
+	others := make([]string, 0, len(h.others))
+	for method := range h.others {
+		others = append(others, method)
+	}
+	sort.Strings(others)
+
+	return append(methods, others...)
+}
+
 func handlerNotNilCount(handlers ...HandlerFunc) (c int) {
 	for i := range handlers {
 		if handlers[i] != nil {
@@ -285,30 +383,66 @@ func handlerNotNilCount(handlers ...HandlerFunc) (c int) {
 	return
 }
 
-func findParamKeys(path string) (res []routeParam) {
-	parts := strings.Split(path, "/")
-	for i, v := range parts {
-		startMarkerIdx := strings.Index(v, "{")
-		endMarkerIdx := strings.Index(v, "}")
+// extractPathParams extracts params from a request path matched with the route tree into dst,
+// which is allocated when nil.
+func extractPathParams(dst PathParams, path string, params *[]routeParam) PathParams {
+	if params == nil || len(*params) == 0 {
+		return dst
+	}
 
-		if startMarkerIdx == -1 || endMarkerIdx == -1 {
+	if dst == nil {
+		dst = make(PathParams, len(*params))
+	}
+
+	for _, p := range *params {
+		value, rest, ok := pathSegment(path, p.index)
+		if !ok {
+			// error?
 			continue
 		}
 
-		v = v[startMarkerIdx+1 : endMarkerIdx]
-		rp := routeParam{
-			index:    i,
-			isGreedy: v[len(v)-1] == '+',
+		if p.isGreedy {
+			dst[p.key] = PathParam{
+				Key:   p.key,
+				Value: rest,
+			}
+			break
 		}
 
-		if rp.isGreedy {
-			rp.key = v[:len(v)-1]
+		if p.pattern != nil {
+			values := p.pattern.extract(value)
+			if p.capture >= len(values) {
+				continue
+			}
+			value = values[p.capture]
 		}
 
-		res = append(res, rp)
+		dst[p.key] = PathParam{
+			Key:   p.key,
+			Value: value,
+		}
 	}
 
-	return
+	return dst
+}
+
+// pathSegment returns the segment of path at index as strings.Split(path, "/") would,
+// and path from the segment to the end.
+func pathSegment(path string, index int) (seg string, rest string, ok bool) {
+	for ; index > 0; index-- {
+		i := strings.IndexByte(path, '/')
+		if i == -1 {
+			return "", "", false
+		}
+		path = path[i+1:]
+	}
+
+	seg = path
+	if i := strings.IndexByte(path, '/'); i != -1 {
+		seg = path[:i]
+	}
+
+	return seg, path, true
 }
 
 func replaceMethodWildcardToBlank(method string) string {
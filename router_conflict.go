@@ -0,0 +1,129 @@
+package golam
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RouteConflictKind uint8
+
+const (
+	// RouteConflictDuplicate is a method registered twice on the same path pattern.
+	// the later handler replaces the earlier one.
+	RouteConflictDuplicate RouteConflictKind = iota + 1
+
+	// RouteConflictParamName is a path variable with another name on the same node, e.g. "/u/{id}" and "/u/{userId}".
+	RouteConflictParamName
+
+	// RouteConflictGreedy is a greedy path variable with another name on the same node,
+	// e.g. "/{proxy+}" and "/{path+}", which API Gateway rejects as ambiguous.
+	RouteConflictGreedy
+)
+
+func (k RouteConflictKind) String() string {
+	switch k {
+	case RouteConflictDuplicate:
+		return "duplicate route"
+	case RouteConflictParamName:
+		return "conflicting path variable"
+	case RouteConflictGreedy:
+		return "ambiguous greedy path variable"
+	default:
+		return "unknown"
+	}
+}
+
+type (
+	RouteConflictError struct {
+		Kind   RouteConflictKind
+		Method string
+		Path   string
+
+		// Existing is the path pattern registered first on the conflicting node.
+		Existing string
+	}
+
+	RouteConflicts []*RouteConflictError
+)
+
+var (
+	_ error = (*RouteConflictError)(nil)
+	_ error = (RouteConflicts)(nil)
+)
+
+func (e *RouteConflictError) Error() string {
+	if e.Kind == RouteConflictDuplicate {
+		return fmt.Sprintf("%s: %s %s is already registered", e.Kind, e.Method, e.Path)
+	}
+
+	return fmt.Sprintf("%s: %s %s conflicts with %s", e.Kind, e.Method, e.Path, e.Existing)
+}
+
+func (rc RouteConflicts) Error() string {
+	messages := make([]string, len(rc))
+	for i, e := range rc {
+		messages[i] = e.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// without returns conflicts except those of the route method, after the route is deleted.
+func (rc RouteConflicts) without(method string, path string) RouteConflicts {
+	method = routeMethodName(method)
+
+	var result RouteConflicts
+	for _, e := range rc {
+		if e.Method != method || e.Path != path {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+func newDuplicateRouteConflict(method string, path string) *RouteConflictError {
+	return &RouteConflictError{
+		Kind:     RouteConflictDuplicate,
+		Method:   routeMethodName(method),
+		Path:     path,
+		Existing: path,
+	}
+}
+
+func newParamNameConflict(method string, path string, seg parsedSegment, existing *routerNode) *RouteConflictError {
+	kind := RouteConflictParamName
+	if seg.kind == segmentKindGreedy {
+		kind = RouteConflictGreedy
+	}
+
+	return &RouteConflictError{
+		Kind:     kind,
+		Method:   routeMethodName(method),
+		Path:     path,
+		Existing: existing.path,
+	}
+}
+
+// routeMethodName returns the method as written by users, RouteMethodAny for the Any handler.
+func routeMethodName(method string) string {
+	if method == "" {
+		return RouteMethodAny
+	}
+
+	return method
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
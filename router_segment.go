@@ -0,0 +1,208 @@
+package golam
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type segmentKind uint8
+
+const (
+	segmentKindStatic segmentKind = iota
+	// segmentKindParam is a whole segment path variable without constraint, e.g. "{id}".
+	segmentKindParam
+	// segmentKindGreedy is a greedy path variable, e.g. "{proxy+}".
+	segmentKindGreedy
+	// segmentKindPattern is a segment with literal text around path variables or typed path variables,
+	// e.g. "report-{id}.csv", "{from}-{to}", "{id:int}".
+	segmentKindPattern
+)
+
+// path variable types usable as "{name:type}". other constraints are regular expressions.
+var segmentParamTypes = map[string]string{
+	"int":   `-?[0-9]+`,
+	"uint":  `[0-9]+`,
+	"alpha": `[a-zA-Z]+`,
+	"alnum": `[a-zA-Z0-9]+`,
+	"uuid":  `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+}
+
+type parsedSegment struct {
+	kind    segmentKind
+	raw     string
+	names   []string
+	pattern *segmentPattern
+	tokens  []segmentToken
+}
+
+// segmentToken is literal text or a path variable of a segment.
+type segmentToken struct {
+	literal    string
+	name       string
+	constraint string
+	isParam    bool
+}
+
+type segmentPattern struct {
+	// key identifies the shape of the segment without param names, e.g. "report-{:}.csv", "{:int}".
+	key      string
+	literals int
+	re       *regexp.Regexp
+	groups   []int
+}
+
+func (sp *segmentPattern) match(s string) bool {
+	return sp.re.MatchString(s)
+}
+
+func (sp *segmentPattern) extract(s string) []string {
+	m := sp.re.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+
+	values := make([]string, len(sp.groups))
+	for i, g := range sp.groups {
+		values[i] = m[g]
+	}
+	return values
+}
+
+// parseSegment parses one path segment of a route.
+// braces in constraints are allowed, e.g. "{code:[0-9]{3}}".
+func parseSegment(seg string) (ps parsedSegment, err error) {
+	ps.raw = seg
+
+	var tokens []segmentToken
+	var literal strings.Builder
+	for i := 0; i < len(seg); i++ {
+		if seg[i] != '{' {
+			literal.WriteByte(seg[i])
+			continue
+		}
+
+		depth, end := 0, -1
+		for j := i; j < len(seg); j++ {
+			switch seg[j] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+
+			if depth == 0 {
+				end = j
+				break
+			}
+		}
+
+		if end == -1 {
+			return ps, fmt.Errorf("unclosed path variable in %q", seg)
+		}
+
+		if literal.Len() > 0 {
+			tokens = append(tokens, segmentToken{literal: literal.String()})
+			literal.Reset()
+		}
+
+		t := segmentToken{name: seg[i+1 : end], isParam: true}
+		if idx := strings.IndexByte(t.name, ':'); idx != -1 {
+			t.name, t.constraint = t.name[:idx], t.name[idx+1:]
+		}
+
+		if t.name == "" {
+			return ps, fmt.Errorf("empty path variable name in %q", seg)
+		}
+
+		tokens = append(tokens, t)
+		i = end
+	}
+
+	if literal.Len() > 0 {
+		tokens = append(tokens, segmentToken{literal: literal.String()})
+	}
+
+	ps.tokens = tokens
+	switch {
+	case len(tokens) == 0 || (len(tokens) == 1 && !tokens[0].isParam):
+		ps.kind = segmentKindStatic
+		return
+	case len(tokens) == 1 && tokens[0].constraint == "" && strings.HasSuffix(tokens[0].name, "+"):
+		ps.kind = segmentKindGreedy
+		ps.names = []string{strings.TrimSuffix(tokens[0].name, "+")}
+		return
+	case len(tokens) == 1 && tokens[0].constraint == "":
+		ps.kind = segmentKindParam
+		ps.names = []string{tokens[0].name}
+		return
+	}
+
+	ps.kind = segmentKindPattern
+	ps.pattern = &segmentPattern{}
+
+	var key, expr strings.Builder
+	expr.WriteByte('^')
+	for _, t := range tokens {
+		if !t.isParam {
+			key.WriteString(t.literal)
+			expr.WriteString(regexp.QuoteMeta(t.literal))
+			ps.pattern.literals += len(t.literal)
+			continue
+		}
+
+		if strings.HasSuffix(t.name, "+") {
+			return ps, fmt.Errorf("greedy path variable must be a whole segment: %q", seg)
+		}
+
+		constraint := t.constraint
+		if typed, ok := segmentParamTypes[constraint]; ok {
+			constraint = typed
+		} else if constraint == "" {
+			constraint = `.+?`
+		}
+
+		key.WriteString("{:" + t.constraint + "}")
+		expr.WriteString("(?P<p" + strconv.Itoa(len(ps.names)) + ">" + constraint + ")")
+		ps.names = append(ps.names, t.name)
+	}
+	expr.WriteByte('$')
+
+	ps.pattern.re, err = regexp.Compile(expr.String())
+	if err != nil {
+		return ps, fmt.Errorf("invalid path variable constraint in %q: %w", seg, err)
+	}
+
+	ps.pattern.key = key.String()
+	for i := range ps.names {
+		ps.pattern.groups = append(ps.pattern.groups, ps.pattern.re.SubexpIndex("p"+strconv.Itoa(i)))
+	}
+	return
+}
+
+// withoutConstraints returns the segment without constraints of path variables, e.g. "{id}" for "{id:int}",
+// as API Gateway writes it in route keys.
+func (ps parsedSegment) withoutConstraints() string {
+	if ps.kind == segmentKindStatic {
+		return ps.raw
+	}
+
+	var b strings.Builder
+	for _, t := range ps.tokens {
+		if t.isParam {
+			b.WriteString("{" + t.name + "}")
+		} else {
+			b.WriteString(t.literal)
+		}
+	}
+	return b.String()
+}
+
+// sortSegmentPatterns orders pattern nodes so that segments with more literal text are tried first.
+func sortSegmentPatterns(nodes []*routerNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].segment.literals > nodes[j].segment.literals
+	})
+}
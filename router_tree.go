@@ -0,0 +1,475 @@
+package golam
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// newTreeRouter returns the route tree. onConflict is called for each conflict found by AddRoute.
+func newTreeRouter(onConflict func(err *RouteConflictError)) Router {
+	return &treeRouter{
+		root: routerNode{
+			path:     "",
+			children: make(map[string]*routerNode),
+		},
+		onConflict: onConflict,
+	}
+}
+
+var _ Router = (*treeRouter)(nil)
+
+type routerNode struct {
+	path           string
+	route          *route
+	greedyWildcard *routerNode
+	wildcard       *routerNode
+	patterns       []*routerNode
+	segment        *segmentPattern
+	children       map[string]*routerNode
+
+	// names is path variable names of the segment of the node, from the first registered route.
+	names []string
+}
+
+func (n *routerNode) isEmpty() bool {
+	return n.route == nil && n.wildcard == nil && n.greedyWildcard == nil &&
+		len(n.patterns) == 0 && len(n.children) == 0
+}
+
+// child returns the child node for seg, or nil.
+func (n *routerNode) child(seg parsedSegment) *routerNode {
+	switch seg.kind {
+	case segmentKindGreedy:
+		return n.greedyWildcard
+	case segmentKindParam:
+		return n.wildcard
+	case segmentKindPattern:
+		for _, pn := range n.patterns {
+			if pn.segment.key == seg.pattern.key {
+				return pn
+			}
+		}
+		return nil
+	default:
+		return n.children[seg.raw]
+	}
+}
+
+func (n *routerNode) addChild(seg parsedSegment, path string) *routerNode {
+	next := &routerNode{
+		path:     path,
+		children: make(map[string]*routerNode),
+		names:    seg.names,
+	}
+
+	switch seg.kind {
+	case segmentKindGreedy:
+		n.greedyWildcard = next
+	case segmentKindParam:
+		n.wildcard = next
+	case segmentKindPattern:
+		next.segment = seg.pattern
+		n.patterns = append(n.patterns, next)
+		sortSegmentPatterns(n.patterns)
+	default:
+		n.children[seg.raw] = next
+	}
+
+	return next
+}
+
+func (n *routerNode) removeChild(child *routerNode, seg parsedSegment) {
+	switch child {
+	case n.greedyWildcard:
+		n.greedyWildcard = nil
+	case n.wildcard:
+		n.wildcard = nil
+	case n.children[seg.raw]:
+		delete(n.children, seg.raw)
+	default:
+		for i, pn := range n.patterns {
+			if pn == child {
+				n.patterns = append(n.patterns[:i], n.patterns[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+type treeRouter struct {
+	root       routerNode
+	conflicts  RouteConflicts
+	onConflict func(err *RouteConflictError)
+	wrap       func(handler HandlerFunc) HandlerFunc
+}
+
+func (lr *treeRouter) conflict(err *RouteConflictError) {
+	lr.conflicts = append(lr.conflicts, err)
+	if lr.onConflict != nil {
+		lr.onConflict(err)
+	}
+}
+
+func (lr *treeRouter) Conflicts() RouteConflicts {
+	return lr.conflicts
+}
+
+func (lr *treeRouter) FindRoute(path string) *route {
+	path = replaceRootToEmpty(path)
+	if i := strings.IndexByte(path, '/'); i != -1 {
+		return lr.root.match(path[i:])
+	}
+	return lr.root.route
+}
+
+// match finds the route of path under n, backtracking on dead ends.
+// path is the rest of the request path from the slash before the next segment, "" when no segment is left.
+// it is sliced instead of split, so that static routes are found without allocations.
+// at each segment a static child is tried first, then segments with literal text or typed path variables,
+// then a path variable, then a greedy path variable. static, param and greedy is the same priority as API Gateway.
+func (n *routerNode) match(path string) *route {
+	if path == "" {
+		return n.route
+	}
+
+	seg, rest := path[1:], ""
+	if i := strings.IndexByte(seg, '/'); i != -1 {
+		seg, rest = seg[:i], seg[i:]
+	}
+
+	if next := n.children[seg]; next != nil {
+		if r := next.match(rest); r != nil {
+			return r
+		}
+	}
+
+	// path variables never match empty segments.
+	if seg == "" {
+		return nil
+	}
+
+	for _, pn := range n.patterns {
+		if !pn.segment.match(seg) {
+			continue
+		}
+
+		if r := pn.match(rest); r != nil {
+			return r
+		}
+	}
+
+	if n.wildcard != nil {
+		if r := n.wildcard.match(rest); r != nil {
+			return r
+		}
+	}
+
+	if n.greedyWildcard != nil {
+		return n.greedyWildcard.route
+	}
+
+	return nil
+}
+
+func (lr *treeRouter) AddRoute(method string, path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	method = replaceMethodWildcardToBlank(method)
+	routePath := path
+	path = replaceRootToEmpty(path)
+	parts := strings.Split(path, "/")
+	targetRoute := &lr.root.route
+
+	curPath := "/"
+	apiGatewayPath := ""
+
+	var pathParams []routeParam
+	conflicted := false
+	if len(parts) > 1 {
+		cur := &lr.root
+		parts = parts[1:]
+
+		for i, p := range parts {
+			curPath += p + "/"
+
+			seg, err := parseSegment(p)
+			if err != nil {
+				panic(err)
+			}
+			apiGatewayPath += "/" + seg.withoutConstraints()
+
+			switch seg.kind {
+			case segmentKindGreedy:
+				if i != len(parts)-1 {
+					panic("{greedy path variable+} must write suffix")
+				}
+
+				pathParams = append(pathParams, routeParam{
+					index:    i + 1,
+					key:      seg.names[0],
+					isGreedy: true,
+				})
+			case segmentKindParam:
+				pathParams = append(pathParams, routeParam{
+					index: i + 1,
+					key:   seg.names[0],
+				})
+			case segmentKindPattern:
+				for capture, name := range seg.names {
+					pathParams = append(pathParams, routeParam{
+						index:   i + 1,
+						key:     name,
+						pattern: seg.pattern,
+						capture: capture,
+					})
+				}
+			}
+
+			next := cur.child(seg)
+			if next == nil {
+				next = cur.addChild(seg, curPath[:len(curPath)-1])
+			} else if !equalStrings(next.names, seg.names) {
+				lr.conflict(newParamNameConflict(method, routePath, seg, next))
+				conflicted = true
+			}
+
+			cur = next
+		}
+
+		targetRoute = &cur.route
+	}
+
+	if *targetRoute == nil {
+		*targetRoute = &route{
+			path:           curPath[:len(curPath)-1],
+			apiGatewayPath: apiGatewayPath,
+		}
+	}
+
+	if !conflicted && (*targetRoute).handlers.getHandler(method) != nil {
+		lr.conflict(newDuplicateRouteConflict(method, routePath))
+	}
+
+	if (*targetRoute).meta == nil {
+		(*targetRoute).meta = make(map[string]routeMeta)
+	}
+	(*targetRoute).meta[method] = routeMeta{
+		handler:    handlerName(handler),
+		middleware: len(middleware),
+	}
+	(*targetRoute).params.setParamsInfo(method, pathParams)
+	handler = wrapMiddleware(handler, middleware...)
+	(*targetRoute).handlers.setHandler(method, handler)
+	(*targetRoute).chains.setHandler(method, lr.compile(handler))
+}
+
+func (lr *treeRouter) compile(handler HandlerFunc) HandlerFunc {
+	if lr.wrap == nil {
+		return handler
+	}
+
+	return lr.wrap(handler)
+}
+
+func (lr *treeRouter) Compile(wrap func(handler HandlerFunc) HandlerFunc) {
+	lr.wrap = wrap
+	lr.root.walk(func(r *route) {
+		for _, method := range append([]string{""}, r.handlers.allowedMethods()...) {
+			if handler := r.handlers.getHandler(method); handler != nil {
+				r.chains.setHandler(method, lr.compile(handler))
+			}
+		}
+	})
+}
+
+func (lr *treeRouter) DelRoute(method string, path string) {
+	lr.conflicts = lr.conflicts.without(replaceMethodWildcardToBlank(method), path)
+	path = replaceRootToEmpty(path)
+	parts := strings.Split(path, "/")
+
+	type visited struct {
+		parent *routerNode
+		node   *routerNode
+		seg    parsedSegment
+	}
+
+	cur := &lr.root
+	var trail []visited
+	for _, p := range parts[1:] {
+		seg, err := parseSegment(p)
+		if err != nil {
+			return
+		}
+
+		next := cur.child(seg)
+		if next == nil {
+			return
+		}
+
+		trail = append(trail, visited{parent: cur, node: next, seg: seg})
+		cur = next
+	}
+
+	if cur.route == nil {
+		return
+	}
+
+	method = replaceMethodWildcardToBlank(method)
+	cur.route.handlers.delHandler(method)
+	cur.route.chains.delHandler(method)
+	cur.route.params.delParamsInfo(method)
+	delete(cur.route.meta, method)
+	if cur.route.handlers.countHandler() == 0 && cur.route.params.countParamsInfo() == 0 {
+		cur.route = nil
+	}
+
+	remaining := len(trail)
+	for ; remaining > 0; remaining-- {
+		v := trail[remaining-1]
+		if !v.node.isEmpty() {
+			break
+		}
+
+		v.parent.removeChild(v.node, v.seg)
+	}
+
+	for _, v := range trail[:remaining] {
+		if v.node.route == nil {
+			lr.reassignNode(v.node)
+		}
+	}
+}
+
+// reassignNode takes path variable names of the node from the first route under it,
+// after the route that registered the node is deleted.
+// conflicts with the previous names are dropped or pointed to the new names.
+func (lr *treeRouter) reassignNode(n *routerNode) {
+	var first *route
+	n.walk(func(r *route) {
+		if first == nil {
+			first = r
+		}
+	})
+	if first == nil {
+		return
+	}
+
+	depth := strings.Count(n.path, "/")
+	path := pathPrefix(first.path, depth)
+	if path == n.path {
+		return
+	}
+
+	seg, err := parseSegment(path[strings.LastIndexByte(path, '/')+1:])
+	if err != nil {
+		return
+	}
+
+	previous := n.path
+	n.path = path
+	n.names = seg.names
+
+	var conflicts RouteConflicts
+	for _, e := range lr.conflicts {
+		if e.Existing == previous {
+			if pathPrefix(e.Path, depth) == path {
+				continue
+			}
+			reassigned := *e
+			reassigned.Existing = path
+			e = &reassigned
+		}
+		conflicts = append(conflicts, e)
+	}
+	lr.conflicts = conflicts
+}
+
+// pathPrefix returns the first segments of the path, e.g. "/a/b" for "/a/b/c" and 2.
+func pathPrefix(path string, segments int) string {
+	for i := 0; i < len(path); i++ {
+		if path[i] == '/' {
+			if segments == 0 {
+				return path[:i]
+			}
+			segments--
+		}
+	}
+
+	return path
+}
+
+func (lr *treeRouter) Routes() (routes []RouteInfo) {
+	lr.root.walk(func(r *route) {
+		routes = append(routes, r.routeInfos()...)
+	})
+	return
+}
+
+// walk calls fn for each route under n, static children in lexical order first,
+// then pattern, path variable and greedy path variable children.
+func (n *routerNode) walk(fn func(r *route)) {
+	if n.route != nil {
+		fn(n.route)
+	}
+
+	keys := make([]string, 0, len(n.children))
+	for k := range n.children {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		n.children[k].walk(fn)
+	}
+
+	for _, pn := range n.patterns {
+		pn.walk(fn)
+	}
+
+	if n.wildcard != nil {
+		n.wildcard.walk(fn)
+	}
+
+	if n.greedyWildcard != nil {
+		n.greedyWildcard.walk(fn)
+	}
+}
+
+func (lr *treeRouter) Any(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute("", path, handler, middleware...)
+}
+
+func (lr *treeRouter) GET(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodGet, path, handler, middleware...)
+}
+
+func (lr *treeRouter) HEAD(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodHead, path, handler, middleware...)
+}
+
+func (lr *treeRouter) POST(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodPost, path, handler, middleware...)
+}
+
+func (lr *treeRouter) PUT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodPut, path, handler, middleware...)
+}
+
+func (lr *treeRouter) PATCH(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodPatch, path, handler, middleware...)
+}
+
+func (lr *treeRouter) DELETE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodDelete, path, handler, middleware...)
+}
+
+func (lr *treeRouter) CONNECT(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodConnect, path, handler, middleware...)
+}
+
+func (lr *treeRouter) OPTIONS(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodOptions, path, handler, middleware...)
+}
+
+func (lr *treeRouter) TRACE(path string, handler HandlerFunc, middleware ...MiddlewareFunc) {
+	lr.AddRoute(http.MethodTrace, path, handler, middleware...)
+}
@@ -0,0 +1,83 @@
+package golam
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+)
+
+// RouteMethodAny is RouteInfo.Method of routes registered with Any.
+const RouteMethodAny = "*"
+
+// RouteInfo describes a registered route.
+type RouteInfo struct {
+	Method string `json:"method"`
+
+	// Path is the path pattern as registered, e.g. "/users/{id:int}", "/{proxy+}".
+	Path string `json:"path"`
+
+	Params []string `json:"params,omitempty"`
+
+	// Name is the name given with RouteRef.Name, if any.
+	Name string `json:"name,omitempty"`
+
+	// Handler is the function name of the handler.
+	Handler string `json:"handler"`
+
+	// Middleware is the count of route and group middleware, not including Golam.Pre and Golam.Use.
+	Middleware int `json:"middleware"`
+}
+
+// Routes returns registered routes, e.g. to check an API Gateway configuration against the code.
+func (g *Golam) Routes() []RouteInfo {
+	routes := g.router.Routes()
+	if len(g.routeNames) == 0 {
+		return routes
+	}
+
+	names := make(map[routeName]string, len(g.routeNames))
+	for name, rn := range g.routeNames {
+		names[rn] = name
+	}
+
+	for i := range routes {
+		routes[i].Name = names[routeName{method: routes[i].Method, path: routes[i].Path}]
+	}
+
+	return routes
+}
+
+// PrintRoutes writes the route table to w.
+func (g *Golam) PrintRoutes(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tNAME\tPARAMS\tHANDLER")
+	for _, r := range g.Routes() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", r.Method, r.Path, r.Name, strings.Join(r.Params, ","), r.Handler)
+	}
+
+	return tw.Flush()
+}
+
+// RoutesHandler returns a handler responding the route table as JSON.
+// register it only for debugging, e.g. g.GET("/debug/routes", g.RoutesHandler()).
+func (g *Golam) RoutesHandler() HandlerFunc {
+	return func(c Context) error {
+		return c.JSON(http.StatusOK, g.Routes())
+	}
+}
+
+func handlerName(handler HandlerFunc) string {
+	if handler == nil {
+		return ""
+	}
+
+	if f := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()); f != nil {
+		return f.Name()
+	}
+
+	return ""
+}
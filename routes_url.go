@@ -0,0 +1,151 @@
+package golam
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var ErrRouteNameNotFound = errors.New("route name not found")
+
+// RouteRef is a registered route, returned by the registration methods of Golam and Group.
+type RouteRef struct {
+	golam  *Golam
+	Method string
+	Path   string
+}
+
+// routeName is the method and the path pattern of a named route.
+type routeName struct {
+	method string
+	path   string
+}
+
+// Name names the route for Golam.URL, e.g. g.GET("/users/{id}", h).Name("user.show").
+// a name can be used only for one route, methods of the same path can have different names.
+func (r *RouteRef) Name(name string) *RouteRef {
+	r.golam.nameRoute(name, routeName{method: r.Method, path: r.Path})
+	return r
+}
+
+func (g *Golam) nameRoute(name string, rn routeName) {
+	if rn.path == "" {
+		rn.path = "/"
+	}
+
+	if g.routeNames == nil {
+		g.routeNames = make(map[string]routeName)
+	}
+
+	if existing, ok := g.routeNames[name]; ok && existing != rn {
+		panic(fmt.Sprintf("route name %q is already used for %s %s", name, existing.method, existing.path))
+	}
+
+	g.routeNames[name] = rn
+}
+
+// URL is BuildURL returning "" on errors, e.g. for c.Redirect(http.StatusFound, g.URL("user.show", "id", "42")).
+func (g *Golam) URL(name string, params ...string) string {
+	u, err := g.BuildURL(name, params...)
+	if err != nil {
+		return ""
+	}
+
+	return u
+}
+
+// BuildURL builds the path of the named route from key and value pairs.
+// path variables are filled in with escaped values, slashes are kept in greedy path variables.
+// pairs of other keys become the query string.
+//
+//	g.BuildURL("user.show", "id", "42", "tab", "posts") // "/users/42?tab=posts"
+func (g *Golam) BuildURL(name string, params ...string) (string, error) {
+	rn, ok := g.routeNames[name]
+	path := rn.path
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrRouteNameNotFound, name)
+	}
+
+	if len(params)%2 != 0 {
+		return "", fmt.Errorf("odd count of params for route %q", name)
+	}
+
+	values := make(map[string]string, len(params)/2)
+	var query url.Values
+	for i := 0; i < len(params); i += 2 {
+		values[params[i]] = params[i+1]
+	}
+
+	used := make(map[string]bool, len(values))
+	parts := strings.Split(path, "/")
+	for i, p := range parts {
+		seg, err := parseSegment(p)
+		if err != nil {
+			return "", err
+		}
+
+		if seg.kind == segmentKindStatic {
+			continue
+		}
+
+		var b strings.Builder
+		for _, t := range seg.tokens {
+			if !t.isParam {
+				b.WriteString(t.literal)
+				continue
+			}
+
+			key := strings.TrimSuffix(t.name, "+")
+			value, ok := values[key]
+			if !ok {
+				return "", fmt.Errorf("missing path variable %q for route %q", key, name)
+			}
+			used[key] = true
+
+			if seg.kind == segmentKindGreedy {
+				b.WriteString(escapeGreedyPath(value))
+			} else {
+				b.WriteString(url.PathEscape(value))
+			}
+		}
+
+		if seg.kind == segmentKindPattern && !seg.pattern.match(b.String()) {
+			return "", fmt.Errorf("path variables of route %q do not match %s", name, p)
+		}
+
+		parts[i] = b.String()
+	}
+
+	for i := 0; i < len(params); i += 2 {
+		if used[params[i]] {
+			continue
+		}
+
+		if query == nil {
+			query = make(url.Values)
+		}
+		query.Add(params[i], params[i+1])
+	}
+
+	u := strings.Join(parts, "/")
+	if u == "" {
+		u = "/"
+	}
+
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u, nil
+}
+
+// escapeGreedyPath escapes each segment of a greedy path variable value.
+func escapeGreedyPath(value string) string {
+	parts := strings.Split(value, "/")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+
+	return strings.Join(parts, "/")
+}
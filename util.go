@@ -3,7 +3,9 @@ package golam
 import (
 	"github.com/aws/aws-lambda-go/events"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 func getSchemeFromHeader(header http.Header) string {
@@ -23,10 +25,19 @@ func getSchemeFromHeader(header http.Header) string {
 }
 
 func getHeaderFromAPIGatewayV2HTTPRequest(request *events.APIGatewayV2HTTPRequest) (header http.Header) {
+	return getHeaderWithCookies(request.Headers, request.Cookies)
+}
+
+// getHeaderWithCookies builds header of payload v2 events, which carry cookies separately.
+func getHeaderWithCookies(headers map[string]string, cookies []string) (header http.Header) {
 	header = make(http.Header)
-	for k, v := range request.Headers {
+	for k, v := range headers {
 		header.Set(k, v)
 	}
+
+	if len(cookies) > 0 {
+		header.Set(HeaderCookie, strings.Join(cookies, "; "))
+	}
 	return
 }
 
@@ -42,3 +53,34 @@ func getContentLengthFromAPIGatewayV2HTTPRequest(request *events.APIGatewayV2HTT
 	l, _ = strconv.ParseInt(request.Headers[lambdaHeaderContentLength], 10, 0)
 	return
 }
+
+// getHeaderFromMultiValue merges single and multi value headers of Lambda events.
+// multi value headers win when a key exists in both.
+func getHeaderFromMultiValue(single map[string]string, multi map[string][]string) (header http.Header) {
+	header = make(http.Header, len(single))
+	for k, v := range single {
+		header.Set(k, v)
+	}
+
+	for k, v := range multi {
+		header.Del(k)
+		for _, vv := range v {
+			header.Add(k, vv)
+		}
+	}
+	return
+}
+
+// getQueryFromMultiValue merges single and multi value query parameters of Lambda events.
+// multi value parameters win when a key exists in both.
+func getQueryFromMultiValue(single map[string]string, multi map[string][]string) (query url.Values) {
+	query = make(url.Values, len(single))
+	for k, v := range single {
+		query.Set(k, v)
+	}
+
+	for k, v := range multi {
+		query[k] = append([]string(nil), v...)
+	}
+	return
+}
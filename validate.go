@@ -0,0 +1,346 @@
+package golam
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	validateTag = "validate"
+
+	validateRuleRequired  = "required"
+	validateRuleOmitEmpty = "omitempty"
+	validateRuleMin       = "min"
+	validateRuleMax       = "max"
+	validateRuleLen       = "len"
+	validateRuleRegex     = "regex"
+	validateRuleOneOf     = "oneof"
+)
+
+const defaultValidationErrorMessage = "Validation Failed"
+
+var ErrValidatorNotRegistered = errors.New("validator not registered")
+
+type Validator interface {
+	Validate(i interface{}) error
+}
+
+type (
+	FieldError struct {
+		Field   string `json:"field"`
+		Rule    string `json:"rule"`
+		Param   string `json:"param,omitempty"`
+		Message string `json:"message"`
+	}
+
+	ValidationErrors []*FieldError
+
+	validationErrorMessage struct {
+		Message string           `json:"message"`
+		Errors  ValidationErrors `json:"errors"`
+	}
+)
+
+var (
+	_ error = (*FieldError)(nil)
+	_ error = (ValidationErrors)(nil)
+)
+
+func (fe *FieldError) Error() string {
+	return fe.Message
+}
+
+func (ve ValidationErrors) Error() string {
+	messages := make([]string, len(ve))
+	for i, fe := range ve {
+		messages[i] = fe.Message
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func newValidationHTTPError(ve ValidationErrors) *HTTPError {
+	return NewHTTPError(http.StatusBadRequest, validationErrorMessage{
+		Message: defaultValidationErrorMessage,
+		Errors:  ve,
+	}).SetInternal(ve)
+}
+
+var _ Validator = (*DefaultValidator)(nil)
+
+// DefaultValidator checks struct fields by the `validate` tag.
+//
+//	Name  string   `json:"name" validate:"required,min=2,max=32"`
+//	Code  string   `validate:"len=6,regex=^[A-Z0-9]+$"`
+//	Kind  string   `validate:"oneof=a b c"`
+//	Age   int      `validate:"omitempty,min=18"`
+//
+// rules apply to zero values too, e.g. 0 fails min=18, unless omitempty is given.
+// nil pointers skip the rules other than required.
+// regex must be the last rule, because the pattern may contain commas.
+type DefaultValidator struct {
+	regexps sync.Map
+}
+
+func (v *DefaultValidator) Validate(i interface{}) error {
+	rv := reflect.ValueOf(i)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	var ve ValidationErrors
+	if err := v.validateValue(rv, "", &ve); err != nil {
+		return err
+	}
+
+	if len(ve) > 0 {
+		return ve
+	}
+
+	return nil
+}
+
+func (v *DefaultValidator) validateValue(rv reflect.Value, prefix string, ve *ValidationErrors) error {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return v.validateValue(rv.Elem(), prefix, ve)
+	case reflect.Struct:
+		return v.validateStruct(rv, prefix, ve)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.validateValue(rv.Index(i), prefix+"["+strconv.Itoa(i)+"]", ve); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *DefaultValidator) validateStruct(rv reflect.Value, prefix string, ve *ValidationErrors) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fv := rv.Field(i)
+		name := prefix
+		if !sf.Anonymous {
+			if name != "" {
+				name += "."
+			}
+			name += validateFieldName(sf)
+		}
+
+		if tag := sf.Tag.Get(validateTag); tag != "" && tag != "-" {
+			fe, err := v.validateField(fv, name, tag)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", rt.Name(), sf.Name, err)
+			}
+
+			if fe != nil {
+				*ve = append(*ve, fe)
+				continue
+			}
+		}
+
+		if err := v.validateValue(fv, name, ve); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *DefaultValidator) validateField(fv reflect.Value, name string, tag string) (*FieldError, error) {
+	rules := splitValidateRules(tag)
+	if fv.IsZero() {
+		for _, rule := range rules {
+			switch rule.name {
+			case validateRuleRequired:
+				return newFieldError(name, rule, name+" is required"), nil
+			case validateRuleOmitEmpty:
+				return nil, nil
+			}
+		}
+	}
+
+	for fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+		if fv.IsNil() {
+			return nil, nil
+		}
+		fv = fv.Elem()
+	}
+
+	for _, rule := range rules {
+		var (
+			ok  bool
+			msg string
+			err error
+		)
+
+		switch rule.name {
+		case validateRuleRequired, validateRuleOmitEmpty:
+			continue
+		case validateRuleMin:
+			ok, err = compareValidateSize(fv, rule.param, func(size, param float64) bool { return size >= param })
+			msg = fmt.Sprintf("%s must be at least %s", name, rule.param)
+		case validateRuleMax:
+			ok, err = compareValidateSize(fv, rule.param, func(size, param float64) bool { return size <= param })
+			msg = fmt.Sprintf("%s must be at most %s", name, rule.param)
+		case validateRuleLen:
+			ok, err = compareValidateSize(fv, rule.param, func(size, param float64) bool { return size == param })
+			msg = fmt.Sprintf("%s must be exactly %s", name, rule.param)
+		case validateRuleRegex:
+			ok, err = v.matchRegex(fv, rule.param)
+			msg = fmt.Sprintf("%s must match %s", name, rule.param)
+		case validateRuleOneOf:
+			ok, err = matchOneOf(fv, rule.param)
+			msg = fmt.Sprintf("%s must be one of [%s]", name, rule.param)
+		default:
+			err = fmt.Errorf("unknown validate rule %q", rule.name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return newFieldError(name, rule, msg), nil
+		}
+	}
+
+	return nil, nil
+}
+
+type validateRule struct {
+	name  string
+	param string
+}
+
+func splitValidateRules(tag string) (rules []validateRule) {
+	for tag != "" {
+		var raw string
+		if strings.HasPrefix(tag, validateRuleRegex+"=") {
+			raw, tag = tag, ""
+		} else if idx := strings.IndexByte(tag, ','); idx != -1 {
+			raw, tag = tag[:idx], tag[idx+1:]
+		} else {
+			raw, tag = tag, ""
+		}
+
+		rule := validateRule{name: raw}
+		if idx := strings.IndexByte(raw, '='); idx != -1 {
+			rule.name, rule.param = raw[:idx], raw[idx+1:]
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return
+}
+
+func newFieldError(name string, rule validateRule, msg string) *FieldError {
+	return &FieldError{
+		Field:   name,
+		Rule:    rule.name,
+		Param:   rule.param,
+		Message: msg,
+	}
+}
+
+func validateFieldName(sf reflect.StructField) string {
+	for _, tag := range []string{"json", bindTagForm, bindTagQuery, bindTagPath, bindTagHeader} {
+		name := sf.Tag.Get(tag)
+		if idx := strings.IndexByte(name, ','); idx != -1 {
+			name = name[:idx]
+		}
+
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return sf.Name
+}
+
+func compareValidateSize(fv reflect.Value, rawParam string, cmp func(size, param float64) bool) (bool, error) {
+	param, err := strconv.ParseFloat(rawParam, 64)
+	if err != nil {
+		return false, err
+	}
+
+	var size float64
+	switch fv.Kind() {
+	case reflect.String:
+		size = float64(utf8.RuneCountInString(fv.String()))
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size = float64(fv.Len())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = float64(fv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size = float64(fv.Uint())
+	case reflect.Float32, reflect.Float64:
+		size = fv.Float()
+	default:
+		return false, fmt.Errorf("size rule is not supported for %s", fv.Type())
+	}
+
+	return cmp(size, param), nil
+}
+
+func (v *DefaultValidator) matchRegex(fv reflect.Value, pattern string) (bool, error) {
+	if fv.Kind() != reflect.String {
+		return false, fmt.Errorf("regex rule is not supported for %s", fv.Type())
+	}
+
+	cached, ok := v.regexps.Load(pattern)
+	if !ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, err
+		}
+		cached, _ = v.regexps.LoadOrStore(pattern, re)
+	}
+
+	return cached.(*regexp.Regexp).MatchString(fv.String()), nil
+}
+
+func matchOneOf(fv reflect.Value, param string) (bool, error) {
+	var s string
+	switch fv.Kind() {
+	case reflect.String:
+		s = fv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(fv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = strconv.FormatUint(fv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		s = strconv.FormatFloat(fv.Float(), 'f', -1, 64)
+	default:
+		return false, fmt.Errorf("oneof rule is not supported for %s", fv.Type())
+	}
+
+	for _, candidate := range strings.Fields(param) {
+		if s == candidate {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}